@@ -0,0 +1,154 @@
+package gaga
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Segment is a pair of the corresponding ranges of the source text
+// and the normalized text, in byte offsets.
+type Segment struct {
+	SrcStart int // start of the range in the source text
+	SrcEnd   int // end of the range in the source text
+	OutStart int // start of the range in the normalized text
+	OutEnd   int // end of the range in the normalized text
+}
+
+func (seg *Segment) sameLen() bool {
+	return seg.SrcEnd-seg.SrcStart == seg.OutEnd-seg.OutStart
+}
+
+// Alignment maps byte offsets between a source text and the text
+// normalized from it. The segments are sorted and cover both texts
+// without gaps.
+type Alignment []Segment
+
+// StringWithOffsets is like String, but also returns the Alignment
+// between the s and the normalized string.
+// The characters that the normalization leaves unchanged are merged
+// into a single segment, and any other character (or a base letter and
+// the voicing modifier that follows it) makes a segment by itself.
+// With the context-dependent flags, such as the ExpandIterationMark,
+// a character that is normalized according to the characters next to
+// it is merged into the segment before it.
+func (n *Normalizer) StringWithOffsets(s string) (string, Alignment) {
+	var buf [utf8.UTFMax * 2]byte
+	in := inputString(s)
+	out := make([]byte, 0, len(s)+len(s)/2)
+	a := Alignment{}
+	merge := false
+	// The unit before the p, its context and its start in the out.
+	prev, prevCtx, prevOut := 0, normCtx{}, 0
+	for p := 0; p < len(s); {
+		ctx := in.ctxAt(p)
+		norm, size := n.next(buf[:], &in, p, true)
+		unchanged := len(norm) == size && in.equal(p, norm)
+		switch {
+		case merge && unchanged:
+			a[len(a)-1].SrcEnd += size
+			a[len(a)-1].OutEnd += len(norm)
+		case n.inContext && p > 0 &&
+			n.joinsContext(s, prev, p, size, prevCtx, out[prevOut:], norm, in.nextCtx):
+			a[len(a)-1].SrcEnd += size
+			a[len(a)-1].OutEnd += len(norm)
+		default:
+			a = append(a, Segment{p, p + size, len(out), len(out) + len(norm)})
+		}
+		merge = unchanged
+		prev, prevCtx, prevOut = p, ctx, len(out)
+		out = append(out, norm...)
+		p += size
+	}
+	return string(out), a
+}
+
+// joinsContext reports whether the unit in s[p:p+size], normalized into
+// the norm with the context of nextCtx for the next unit, cannot make
+// a segment apart from the unit in s[prev:p] before it, normalized into
+// the prevNorm in the prevCtx; that is, whether the unit is normalized
+// differently at the start of a text, or the unit before it at the end
+// of a text.
+func (n *Normalizer) joinsContext(s string, prev, p, size int, prevCtx normCtx, prevNorm, norm []byte, nextCtx normCtx) bool {
+	var buf [utf8.UTFMax * 2]byte
+	in := inputString(s)
+	in.nextPos = p
+	out, l := n.nextInContext(buf[:], &in, p, true)
+	if l != size || string(out) != string(norm) || in.nextCtx != nextCtx {
+		return true
+	}
+	in = inputString(s[:p])
+	in.nextPos, in.nextCtx = prev, prevCtx
+	out, l = n.nextInContext(buf[:], &in, prev, true)
+	return l != p-prev || string(out) != string(prevNorm)
+}
+
+// mapStart maps a start offset using the from and to ranges of the
+// segments.
+func (a Alignment) mapStart(off int, from, to func(*Segment) (int, int)) int {
+	i := sort.Search(len(a), func(i int) bool {
+		_, end := from(&a[i])
+		return end > off
+	})
+	if i >= len(a) {
+		if len(a) == 0 {
+			return 0
+		}
+		_, end := to(&a[len(a)-1])
+		return end
+	}
+	seg := &a[i]
+	fromStart, _ := from(seg)
+	toStart, _ := to(seg)
+	if off <= fromStart {
+		return toStart
+	}
+	if seg.sameLen() {
+		return toStart + off - fromStart
+	}
+	return toStart
+}
+
+// mapEnd maps an end offset using the from and to ranges of the
+// segments.
+func (a Alignment) mapEnd(off int, from, to func(*Segment) (int, int)) int {
+	i := sort.Search(len(a), func(i int) bool {
+		_, end := from(&a[i])
+		return end >= off
+	})
+	if i >= len(a) {
+		if len(a) == 0 {
+			return 0
+		}
+		_, end := to(&a[len(a)-1])
+		return end
+	}
+	seg := &a[i]
+	fromStart, _ := from(seg)
+	toStart, toEnd := to(seg)
+	if off <= fromStart {
+		return toStart
+	}
+	if seg.sameLen() {
+		return toStart + off - fromStart
+	}
+	return toEnd
+}
+
+func srcRange(seg *Segment) (int, int) { return seg.SrcStart, seg.SrcEnd }
+func outRange(seg *Segment) (int, int) { return seg.OutStart, seg.OutEnd }
+
+// SourceRange returns the range of the source text that corresponds to
+// the range [start, end) of the normalized text. If the range splits
+// a segment whose length was changed by the normalization, the result
+// is extended to cover the whole segment.
+func (a Alignment) SourceRange(start, end int) (int, int) {
+	return a.mapStart(start, outRange, srcRange), a.mapEnd(end, outRange, srcRange)
+}
+
+// OutputRange returns the range of the normalized text that corresponds
+// to the range [start, end) of the source text. If the range splits
+// a segment whose length was changed by the normalization, the result
+// is extended to cover the whole segment.
+func (a Alignment) OutputRange(start, end int) (int, int) {
+	return a.mapStart(start, srcRange, outRange), a.mapEnd(end, srcRange, outRange)
+}
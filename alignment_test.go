@@ -0,0 +1,150 @@
+package gaga
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type Normalizer_StringWithOffsetsTest struct {
+	flag NormFlag
+	in   string
+	out  string
+	a    Alignment
+}
+
+var normalizer_stringwithoffsetstests = []Normalizer_StringWithOffsetsTest{
+	0:  {Fold, "", "", Alignment{}},
+	1:  {Fold, "abc", "abc", Alignment{{0, 3, 0, 3}}},
+	2:  {Fold, "ｶﾞ", "ガ", Alignment{{0, 6, 0, 3}}},
+	3:  {Fold, "aｶﾞb", "aガb", Alignment{{0, 1, 0, 1}, {1, 7, 1, 4}, {7, 8, 4, 5}}},
+	4:  {Fold, "ＡＢｱ", "ABア", Alignment{{0, 3, 0, 1}, {3, 6, 1, 2}, {6, 9, 2, 5}}},
+	5:  {Fold, "か゛き", "がき", Alignment{{0, 6, 0, 3}, {6, 9, 3, 6}}},
+	6:  {DecomposeVom, "がa", "がa", Alignment{{0, 3, 0, 6}, {3, 4, 6, 7}}},
+	7:  {KanaToHiragana, "アイ", "あい", Alignment{{0, 3, 0, 3}, {3, 6, 3, 6}}},
+	8:  {ExpandIterationMark, "こゝろ", "こころ", Alignment{{0, 6, 0, 6}, {6, 9, 6, 9}}},
+	9:  {KanjiVariantFold | ExpandIterationMark, "髙々", "高高", Alignment{{0, 6, 0, 6}}},
+	10: {CollapseIterationMark, "こころ", "こゝろ", Alignment{{0, 6, 0, 6}, {6, 9, 6, 9}}},
+	11: {ProlongedSoundMarkByContext, "カ-ド", "カード", Alignment{{0, 4, 0, 6}, {4, 7, 6, 9}}},
+	12: {ProlongedSoundMarkByContext, "aーb", "a-b", Alignment{{0, 5, 0, 3}}},
+}
+
+func TestNormalizer_StringWithOffsets(t *testing.T) {
+	for i, tt := range normalizer_stringwithoffsetstests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, a := n.StringWithOffsets(tt.in)
+		if out != tt.out || !reflect.DeepEqual(a, tt.a) {
+			t.Errorf("#%d %s, StringWithOffsets(%q)\n\thave: %q, %v\n\twant: %q, %v",
+				i, tt.flag, tt.in, out, a, tt.out, tt.a)
+		}
+	}
+}
+
+func TestNormalizer_StringWithOffsetsString(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, a := n.StringWithOffsets(tt.in)
+		if out != tt.out {
+			t.Errorf("#%d %s, StringWithOffsets(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, out, tt.out)
+			continue
+		}
+		// Every segment must be normalized independently of the others.
+		var sb strings.Builder
+		for j, seg := range a {
+			if j > 0 && (seg.SrcStart != a[j-1].SrcEnd || seg.OutStart != a[j-1].OutEnd) {
+				t.Errorf("#%d segment %d %v is not contiguous", i, j, seg)
+			}
+			sb.WriteString(n.String(tt.in[seg.SrcStart:seg.SrcEnd]))
+			if have, want := n.String(tt.in[seg.SrcStart:seg.SrcEnd]), out[seg.OutStart:seg.OutEnd]; have != want {
+				t.Errorf("#%d segment %d %v, have: %q, want: %q", i, j, seg, have, want)
+			}
+		}
+		if sb.String() != out {
+			t.Errorf("#%d have: %q, want: %q", i, sb.String(), out)
+		}
+	}
+}
+
+type Alignment_RangeTest struct {
+	flag   NormFlag
+	in     string
+	lo, hi int // the range to be mapped
+	from   string
+	to     string
+}
+
+var alignment_sourcerangetests = []Alignment_RangeTest{
+	0: {Fold, "ＡＢｶﾞＣ", 0, 1, "A", "Ａ"},
+	1: {Fold, "ＡＢｶﾞＣ", 2, 5, "ガ", "ｶﾞ"},
+	2: {Fold, "ＡＢｶﾞＣ", 1, 6, "BガC", "ＢｶﾞＣ"},
+	3: {Fold, "ＡＢｶﾞＣ", 3, 5, "\x82\xac", "ｶﾞ"}, // a part of a rune
+	4: {Fold, "abcｶﾞ", 1, 3, "bc", "bc"},
+	5: {Fold, "abcｶﾞ", 0, 0, "", ""},
+	6: {Fold, "abcｶﾞ", 6, 6, "", ""},
+	7: {Fold, "abcｶﾞ", 0, 100, "abcガ", "abcｶﾞ"},
+	8: {DecomposeVom, "aがb", 1, 4, "か", "が"},
+	9: {DecomposeVom, "aがb", 4, 7, "\u3099", "が"},
+}
+
+func TestAlignment_SourceRange(t *testing.T) {
+	for i, tt := range alignment_sourcerangetests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, a := n.StringWithOffsets(tt.in)
+		hi := tt.hi
+		if hi > len(out) {
+			hi = len(out)
+		}
+		if have := out[tt.lo:hi]; have != tt.from {
+			t.Errorf("#%d test data is invalid, out[%d:%d] = %q, want: %q", i, tt.lo, hi, have, tt.from)
+			continue
+		}
+		lo, hi := a.SourceRange(tt.lo, tt.hi)
+		if have := tt.in[lo:hi]; have != tt.to {
+			t.Errorf("#%d %s, SourceRange(%d, %d) = (%d, %d) = %q, want: %q",
+				i, tt.flag, tt.lo, tt.hi, lo, hi, have, tt.to)
+		}
+	}
+}
+
+var alignment_outputrangetests = []Alignment_RangeTest{
+	0: {Fold, "ＡＢｶﾞＣ", 0, 3, "Ａ", "A"},
+	1: {Fold, "ＡＢｶﾞＣ", 6, 12, "ｶﾞ", "ガ"},
+	2: {Fold, "ＡＢｶﾞＣ", 6, 9, "ｶ", "ガ"}, // a part of a segment
+	3: {Fold, "ＡＢｶﾞＣ", 9, 15, "ﾞＣ", "ガC"},
+	4: {Fold, "abcｶﾞ", 1, 3, "bc", "bc"},
+	5: {Fold, "abcｶﾞ", 9, 9, "", ""},
+	6: {KanaToHiragana, "アイウ", 3, 6, "イ", "い"},
+}
+
+func TestAlignment_OutputRange(t *testing.T) {
+	for i, tt := range alignment_outputrangetests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, a := n.StringWithOffsets(tt.in)
+		if have := tt.in[tt.lo:tt.hi]; have != tt.from {
+			t.Errorf("#%d test data is invalid, in[%d:%d] = %q, want: %q", i, tt.lo, tt.hi, have, tt.from)
+			continue
+		}
+		lo, hi := a.OutputRange(tt.lo, tt.hi)
+		if have := out[lo:hi]; have != tt.to {
+			t.Errorf("#%d %s, OutputRange(%d, %d) = (%d, %d) = %q, want: %q",
+				i, tt.flag, tt.lo, tt.hi, lo, hi, have, tt.to)
+		}
+	}
+}
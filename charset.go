@@ -0,0 +1,167 @@
+package gaga
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+//go:generate go run gen/gencharsettables.go -output charset_tables.go
+
+// Charset is the repertoire of the characters that Check verifies the
+// text with.
+type Charset int
+
+// Constants to identify the character sets.
+const (
+	// JISX0208 is the characters of JIS X 0208 and ASCII.
+	JISX0208 Charset = iota
+
+	// JISX0213 is the characters of JIS X 0213:2004 and ASCII.
+	JISX0213
+
+	// CP932 is the characters of CP932 (Windows-31J), which are the
+	// characters of JIS X 0208, the NEC and IBM extensions, the half-width
+	// Katakana and ASCII, except for the user-defined characters.
+	CP932
+
+	// Zengin is the half-width characters of the Zengin format for the
+	// bank transfers, which are the digits, the upper case Latin letters,
+	// the Katakana from [ｱ] to [ﾝ], [ﾞ], [ﾟ], the space and [()-./\,｢｣].
+	Zengin
+
+	charsetMax
+)
+
+type charsetInfo struct {
+	name  string
+	table *unicode.RangeTable
+	flag  NormFlag // the normalization flag to suggest the replacements
+}
+
+var charsetInfoList = [charsetMax]charsetInfo{
+	JISX0208: {"JISX0208", jisx0208Table,
+		Fold | CP932ToJIS | EnclosedToPlain | CompatToPlain | KanjiVariantFold},
+	JISX0213: {"JISX0213", jisx0213Table,
+		Fold | CP932ToJIS | EnclosedToPlain | CompatToPlain | KanjiVariantFold},
+	CP932: {"CP932", cp932Table,
+		Fold | JISToCP932 | EnclosedToPlain | CompatToPlain | KanjiVariantFold},
+	Zengin: {"Zengin", zenginTable,
+		LatinToNarrow | AlphaToUpper | KanaToNarrow | SmallKanaToLarge | EnclosedToPlain},
+}
+
+// charsetNormalizers suggests the replacements for each Charset.
+var charsetNormalizers = func() [charsetMax]*Normalizer {
+	var ns [charsetMax]*Normalizer
+	for cs, info := range charsetInfoList {
+		n, err := Norm(info.flag)
+		if err != nil {
+			panic(err)
+		}
+		ns[cs] = n
+	}
+	return ns
+}()
+
+func (cs Charset) valid() bool { return 0 <= cs && cs < charsetMax }
+
+// String returns the name of the cs.
+func (cs Charset) String() string {
+	if !cs.valid() {
+		return "<undefined>"
+	}
+	return charsetInfoList[cs].name
+}
+
+// ParseCharset returns the Charset of the name, such as "JISX0208".
+// The name is not case sensitive.
+func ParseCharset(name string) (Charset, error) {
+	for cs, info := range charsetInfoList {
+		if strings.EqualFold(name, info.name) {
+			return Charset(cs), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid character set: %s", name)
+}
+
+// Contains reports whether the r is in the cs.
+func (cs Charset) Contains(r rune) bool {
+	return cs.valid() && unicode.Is(charsetInfoList[cs].table, r)
+}
+
+// containsAll reports whether all the runes of the s are in the cs.
+func (cs Charset) containsAll(s string) bool {
+	for _, r := range s {
+		if !cs.Contains(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Violation is a character of the text that is not in a Charset.
+type Violation struct {
+	Offset  int    // byte offset of the character in the text
+	Line    int    // line number, starting at 1
+	Col     int    // column number in runes, starting at 1
+	Src     []rune // the character, which may be a base letter and its voicing modifier
+	Suggest []rune // the replacement in the Charset, or nil if none is found
+}
+
+// String returns the violation in the form of
+// `U+FF76 U+FF9E "ｶﾞ" -> "ガ"`, or `U+1F600 "😀"` if it has no
+// replacement.
+func (v Violation) String() string {
+	s := fmt.Sprintf("%s %q", formatRunes(v.Src), string(v.Src))
+	if v.Suggest != nil {
+		s += fmt.Sprintf(" -> %q", string(v.Suggest))
+	}
+	return s
+}
+
+// Check returns the characters of the s that are not in the cs, in the
+// order of the s. The line breaks are not checked. The replacement of a
+// character is suggested by normalizing the s with the flags that suit
+// the cs, such as Fold, CP932ToJIS and EnclosedToPlain for JISX0208, or
+// KanaToNarrow and AlphaToUpper for Zengin.
+func Check(s string, cs Charset) []Violation {
+	if !cs.valid() {
+		return nil
+	}
+	out, a := charsetNormalizers[cs].StringWithOffsets(s)
+	var vs []Violation
+	line, col, p := 1, 1, 0
+	// advance moves the position from p to the offset q.
+	advance := func(q int) {
+		for _, r := range s[p:q] {
+			if r == '\n' {
+				line, col = line+1, 1
+			} else {
+				col++
+			}
+		}
+		p = q
+	}
+	for _, seg := range a {
+		src, norm := s[seg.SrcStart:seg.SrcEnd], out[seg.OutStart:seg.OutEnd]
+		if src != norm {
+			// A changed segment is a single character.
+			if !cs.containsAll(src) {
+				advance(seg.SrcStart)
+				v := Violation{Offset: p, Line: line, Col: col, Src: []rune(src)}
+				if norm != "" && cs.containsAll(norm) {
+					v.Suggest = []rune(norm)
+				}
+				vs = append(vs, v)
+			}
+			continue
+		}
+		for i, r := range src {
+			if r != '\n' && r != '\r' && !cs.Contains(r) {
+				advance(seg.SrcStart + i)
+				vs = append(vs, Violation{Offset: p, Line: line, Col: col, Src: []rune{r}})
+			}
+		}
+	}
+	return vs
+}
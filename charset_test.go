@@ -0,0 +1,154 @@
+package gaga
+
+import (
+	"fmt"
+	"testing"
+)
+
+type Charset_ContainsTest struct {
+	cs  Charset
+	in  string
+	out bool
+}
+
+var charset_containstests = []Charset_ContainsTest{
+	0:  {JISX0208, "abc~\\ あア亜熙ΩЯ─〜‖−¢£¬", true},
+	1:  {JISX0208, "ｱ", false},
+	2:  {JISX0208, "①", false},
+	3:  {JISX0208, "～", false},
+	4:  {JISX0208, "髙", false},
+	5:  {JISX0208, "\n", false},
+	6:  {JISX0213, "abc あア亜熙〜①㈱𠀋", true},
+	7:  {JISX0213, "か゚", true},
+	8:  {JISX0213, "ｱ", false},
+	9:  {JISX0213, "😀", false},
+	10: {CP932, "abc あア亜ｱﾞ①㈱髙～∥－￠￡￢", true},
+	11: {CP932, "〜", false},
+	12: {CP932, "−", false},
+	13: {CP932, "𠀋", false},
+	14: {CP932, "❶", false},
+	15: {Zengin, "0123ABCXYZ ｱｶﾞﾊﾟﾝ()-./\\,｢｣", true},
+	16: {Zengin, "a", false},
+	17: {Zengin, "ｧ", false},
+	18: {Zengin, "ｰ", false},
+	19: {Zengin, "ア", false},
+	20: {Charset(-1), "a", false},
+	21: {charsetMax, "a", false},
+}
+
+func TestCharset_Contains(t *testing.T) {
+	for i, tt := range charset_containstests {
+		for _, r := range tt.in {
+			if have := tt.cs.Contains(r); have != tt.out {
+				t.Errorf("#%d %s.Contains(%#U) = %v, want: %v", i, tt.cs, r, have, tt.out)
+			}
+		}
+	}
+}
+
+type Charset_StringTest struct {
+	cs  Charset
+	out string
+}
+
+var charset_stringtests = []Charset_StringTest{
+	0: {JISX0208, "JISX0208"},
+	1: {JISX0213, "JISX0213"},
+	2: {CP932, "CP932"},
+	3: {Zengin, "Zengin"},
+	4: {charsetMax, "<undefined>"},
+}
+
+func TestCharset_String(t *testing.T) {
+	for i, tt := range charset_stringtests {
+		if have := tt.cs.String(); have != tt.out {
+			t.Errorf("#%d String() = %q, want: %q", i, have, tt.out)
+		}
+		cs, err := ParseCharset(tt.out)
+		if tt.cs == charsetMax {
+			if err == nil {
+				t.Errorf("#%d ParseCharset(%q) = %s, want: error", i, tt.out, cs)
+			}
+			continue
+		}
+		if err != nil || cs != tt.cs {
+			t.Errorf("#%d ParseCharset(%q) = %s, %v, want: %s", i, tt.out, cs, err, tt.cs)
+		}
+	}
+	if cs, err := ParseCharset("zengin"); err != nil || cs != Zengin {
+		t.Errorf("ParseCharset(%q) = %s, %v, want: %s", "zengin", cs, err, Zengin)
+	}
+}
+
+type CheckTest struct {
+	cs  Charset
+	in  string
+	out []string
+}
+
+var checktests = []CheckTest{
+	0: {JISX0208, "", nil},
+	1: {JISX0208, "閑さや\r\n岩にしみ入る\n", nil},
+	2: {JISX0208, "ｶﾞｲﾄﾞ", []string{
+		`0 1:1: U+FF76 U+FF9E "ｶﾞ" -> "ガ"`,
+		`6 1:3: U+FF72 "ｲ" -> "イ"`,
+		`9 1:4: U+FF84 U+FF9E "ﾄﾞ" -> "ド"`}},
+	3: {JISX0208, "①髙～\n😀", []string{
+		`0 1:1: U+2460 "①" -> "1"`,
+		`3 1:2: U+9AD9 "髙" -> "高"`,
+		`6 1:3: U+FF5E "～" -> "〜"`,
+		`10 2:1: U+1F600 "😀"`}},
+	4: {JISX0213, "①髙～\n😀𠀋", []string{
+		`3 1:2: U+9AD9 "髙" -> "高"`,
+		`10 2:1: U+1F600 "😀"`}},
+	5: {CP932, "〜−\r\n髙😀", []string{
+		`0 1:1: U+301C "〜" -> "～"`,
+		`3 1:2: U+2212 "−" -> "－"`,
+		`11 2:2: U+1F600 "😀"`}},
+	6: {Zengin, "ﾔﾏﾀﾞ ﾀﾛｳ", nil},
+	7: {Zengin, "やまだ　ｷｬｯﾄ", []string{
+		`0 1:1: U+3084 "や" -> "ﾔ"`,
+		`3 1:2: U+307E "ま" -> "ﾏ"`,
+		`6 1:3: U+3060 "だ" -> "ﾀﾞ"`,
+		`9 1:4: U+3000 "\u3000" -> " "`,
+		`15 1:6: U+FF6C "ｬ" -> "ﾔ"`,
+		`18 1:7: U+FF6F "ｯ" -> "ﾂ"`}},
+	8: {Zengin, "abc㈱", []string{
+		`0 1:1: U+0061 "a" -> "A"`,
+		`1 1:2: U+0062 "b" -> "B"`,
+		`2 1:3: U+0063 "c" -> "C"`,
+		`3 1:4: U+3231 "㈱"`}},
+	9: {charsetMax, "😀", nil},
+}
+
+func TestCheck(t *testing.T) {
+	for i, tt := range checktests {
+		vs := Check(tt.in, tt.cs)
+		if len(vs) != len(tt.out) {
+			t.Errorf("#%d Check(%q, %s) = %v, want: %v", i, tt.in, tt.cs, vs, tt.out)
+			continue
+		}
+		for j, v := range vs {
+			have := fmt.Sprintf("%d %d:%d: %s", v.Offset, v.Line, v.Col, v)
+			if have != tt.out[j] {
+				t.Errorf("#%d Check(%q, %s)[%d]\n\thave: %s\n\twant: %s",
+					i, tt.in, tt.cs, j, have, tt.out[j])
+			}
+		}
+	}
+}
+
+func TestCheckSuggest(t *testing.T) {
+	for i, tt := range checktests {
+		for _, v := range Check(tt.in, tt.cs) {
+			if have := tt.in[v.Offset : v.Offset+len(string(v.Src))]; have != string(v.Src) {
+				t.Errorf("#%d %s, Src = %q, want: %q", i, v, string(v.Src), have)
+			}
+			for _, r := range v.Suggest {
+				if !tt.cs.Contains(r) {
+					t.Errorf("#%d %s, the suggestion is not in %s", i, v, tt.cs)
+				}
+			}
+		}
+	}
+}
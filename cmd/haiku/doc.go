@@ -0,0 +1,63 @@
+/*
+
+Haiku is a utility to check whether haiku and tanka have the right
+number of morae in each verse.
+
+Usage:
+	haiku [flags] [path ...]
+
+The flags are:
+	-v
+		Show version
+	-h
+		Show help
+	-tanka
+		Check tanka (5-7-5-7-7) instead of haiku (5-7-5)
+	-vert
+		Print the result vertically
+	-width
+		Maximum width of vertical output (default: 40)
+	-height
+		Maximum height of vertical output (default: 25)
+
+Poems are separated by blank lines, and each line of a poem is a verse.
+A poem written in a single line is split into verses at spaces.
+The reading of kanji is given by the ruby notation of Aozora Bunko,
+such as "閑《しず》かさや" or "｜閑か《しずか》さや", and kanji without
+a reading are not counted.
+
+Each verse is printed with the number of morae and the expected number,
+followed by "jiamari" if the verse has too many morae or "jitarazu" if
+too few. Haiku exits with status 1 if any poem does not fit the form.
+
+Examples:
+
+To read standard input:
+	$ echo -e "閑《しず》かさや\n岩《いわ》にしみ入《い》る\n蝉《せみ》の声《こえ》" | haiku
+	閑かさや 5/5
+	岩にしみ入る 7/7
+	蝉の声 5/5
+
+To check a poem written in a single line, such as Bashō's haiku of
+which the first verse has too many morae:
+	$ echo "芭蕉《ばしょう》野分《のわき》して 盥《たらい》に雨《あめ》を 聞《き》く夜《よ》かな" | haiku
+	芭蕉野分して 8/5 jiamari
+	盥に雨を 7/7
+	聞く夜かな 5/5
+
+To print the result vertically:
+	$ echo "ふるいけや かわずとびこむ みずのおと" | haiku -vert
+	みかふ
+	ずわる
+	のずい
+	おとけ
+	とびや
+	　こ　
+	５む５
+	／　／
+	５７５
+	  ／
+	  ７
+
+*/
+package main
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/y-bash/go-gaga"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+var version = "v0.0.0" // set value by go build -ldflags
+
+var (
+	haikuMorae = []int{5, 7, 5}
+	tankaMorae = []int{5, 7, 5, 7, 7}
+)
+
+func read(f io.Reader) string {
+	var sb strings.Builder
+	sb.Grow(1024)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		sb.WriteString(sc.Text())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func readfiles(paths []string) (out []string, err error) {
+	if len(paths) == 0 {
+		out = []string{read(os.Stdin)}
+		return
+	}
+	for _, path := range paths {
+		var f *os.File
+		f, err = os.Open(path)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+		out = append(out, read(f))
+	}
+	return
+}
+
+// poems splits the s into the poems separated by empty lines, and each
+// poem into its verses. A poem written in a line is split by spaces.
+func poems(s string) (out [][]string) {
+	var verses []string
+	flush := func() {
+		if len(verses) == 1 {
+			verses = strings.Fields(verses[0])
+		}
+		if len(verses) > 0 {
+			out = append(out, verses)
+		}
+		verses = nil
+	}
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			flush()
+			continue
+		}
+		verses = append(verses, line)
+	}
+	flush()
+	return
+}
+
+// ruby splits the verse annotated with the readings in the form of
+// "閑《しず》かさや" or "｜閑か《しずか》さや" into the text to display and
+// its reading.
+func ruby(verse string) (text, reading string) {
+	var tb, rb strings.Builder
+	var base strings.Builder // the text after '｜'
+	inBase, inRuby := false, false
+	for _, r := range verse {
+		switch {
+		case r == '｜':
+			inBase = true
+		case r == '《':
+			inRuby = true
+		case r == '》':
+			inRuby = false
+			inBase = false
+			base.Reset()
+		case inRuby:
+			rb.WriteRune(r)
+		case inBase:
+			tb.WriteRune(r)
+			base.WriteRune(r)
+		default:
+			tb.WriteRune(r)
+			rb.WriteRune(r)
+		}
+	}
+	// A '｜' without the reading is a part of the text.
+	rb.WriteString(base.String())
+	return tb.String(), rb.String()
+}
+
+// check writes the number of the morae of each verse of the poem against
+// the form to w, and reports whether the poem matches the form.
+// A verse with more morae is marked as jiamari, and that with less morae
+// is marked as jitarazu.
+func check(w io.Writer, poem []string, form []int) bool {
+	ok := true
+	for i := 0; i < len(poem) || i < len(form); i++ {
+		text, n, want := "-", 0, 0
+		if i < len(poem) {
+			var reading string
+			text, reading = ruby(poem[i])
+			n = gaga.CountMorae(reading)
+		}
+		if i < len(form) {
+			want = form[i]
+		}
+		fmt.Fprintf(w, "%s %d/%d", text, n, want)
+		switch {
+		case n > want:
+			fmt.Fprint(w, " jiamari")
+			ok = false
+		case n < want:
+			fmt.Fprint(w, " jitarazu")
+			ok = false
+		}
+		fmt.Fprintln(w)
+	}
+	return ok
+}
+
+// checkstrs checks all the poems in the in against the form, and reports
+// whether all of them match the form.
+func checkstrs(w io.Writer, in []string, form []int) bool {
+	ok := true
+	first := true
+	for _, s := range in {
+		for _, poem := range poems(s) {
+			if !first {
+				fmt.Fprintln(w)
+			}
+			first = false
+			if !check(w, poem, form) {
+				ok = false
+			}
+		}
+	}
+	return ok
+}
+
+// vert writes the s vertically, in which the Latin characters are
+// converted to full-width, so that they are not shifted in the lines.
+func vert(f io.Writer, s string, w, h int) {
+	n, err := gaga.Norm(gaga.LatinToWide)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ss := gaga.VertShrink(n.String(s), w, h)
+	if len(ss) > 0 {
+		fmt.Fprint(f, ss[0])
+		for i := 1; i < len(ss); i++ {
+			fmt.Fprintln(f)
+			fmt.Fprint(f, ss[i])
+		}
+	}
+}
+
+func main() {
+	var v, h, tanka, vertical bool
+	var width, height int
+	flag.BoolVar(&v, "v", false, "show version")
+	flag.BoolVar(&h, "h", false, "show help")
+	flag.BoolVar(&tanka, "tanka", false, "check tanka (5-7-5-7-7) instead of haiku (5-7-5)")
+	flag.BoolVar(&vertical, "vert", false, "print the result vertically")
+	flag.IntVar(&width, "width", 40, "maximum width of vertical output")
+	flag.IntVar(&height, "height", 25, "maximum height of vertical output")
+	flag.Parse()
+	if v {
+		fmt.Println("version:", version)
+		return
+	}
+	if h {
+		flag.Usage()
+		return
+	}
+	if width <= 0 || height <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	form := haikuMorae
+	if tanka {
+		form = tankaMorae
+	}
+	ss, err := readfiles(flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	var sb strings.Builder
+	ok := checkstrs(&sb, ss, form)
+	if vertical {
+		vert(os.Stdout, sb.String(), width, height)
+	} else {
+		fmt.Print(sb.String())
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type CmdHaikuPoemsTest struct {
+	in  string
+	out [][]string
+}
+
+var cmdhaikupoemstests = []CmdHaikuPoemsTest{
+	0: {"", nil},
+	1: {"あ\nい\nう\n", [][]string{{"あ", "い", "う"}}},
+	2: {"あ\nい\n\n\nう\n", [][]string{{"あ", "い"}, {"う"}}},
+	3: {"あ い　う\n", [][]string{{"あ", "い", "う"}}},
+	4: {" あ \n い い \n", [][]string{{"あ", "い い"}}},
+}
+
+func TestCmdHaikuPoems(t *testing.T) {
+	for i, tt := range cmdhaikupoemstests {
+		if have := poems(tt.in); !reflect.DeepEqual(have, tt.out) {
+			t.Errorf("#%d poems(%q) = %q, want: %q", i, tt.in, have, tt.out)
+		}
+	}
+}
+
+type CmdHaikuRubyTest struct {
+	in      string
+	text    string
+	reading string
+}
+
+var cmdhaikurubytests = []CmdHaikuRubyTest{
+	0: {"", "", ""},
+	1: {"しずかさや", "しずかさや", "しずかさや"},
+	2: {"閑《しず》かさや", "閑かさや", "閑しずかさや"},
+	3: {"｜閑か《しずか》さや", "閑かさや", "しずかさや"},
+	4: {"｜閑かさや", "閑かさや", "閑かさや"},
+}
+
+func TestCmdHaikuRuby(t *testing.T) {
+	for i, tt := range cmdhaikurubytests {
+		text, reading := ruby(tt.in)
+		if text != tt.text || reading != tt.reading {
+			t.Errorf("#%d ruby(%q) = %q, %q, want: %q, %q", i, tt.in, text, reading, tt.text, tt.reading)
+		}
+	}
+}
+
+type CmdHaikuCheckTest struct {
+	poem []string
+	form []int
+	out  string
+	ok   bool
+}
+
+var cmdhaikuchecktests = []CmdHaikuCheckTest{
+	0: {[]string{"しずかさや", "いわにしみいる", "せみのこえ"}, haikuMorae,
+		"しずかさや 5/5\nいわにしみいる 7/7\nせみのこえ 5/5\n", true},
+	1: {[]string{"ばしょうのわきして", "たらいにあめを", "きくよかな"}, haikuMorae,
+		"ばしょうのわきして 8/5 jiamari\nたらいにあめを 7/7\nきくよかな 5/5\n", false},
+	2: {[]string{"ふるいけ", "かわずとびこむ"}, haikuMorae,
+		"ふるいけ 4/5 jitarazu\nかわずとびこむ 7/7\n- 0/5 jitarazu\n", false},
+	3: {[]string{"あ", "い", "う", "え"}, []int{1, 1, 1},
+		"あ 1/1\nい 1/1\nう 1/1\nえ 1/0 jiamari\n", false},
+	4: {[]string{"ｷｬｯﾄ", "ラーメン"}, []int{3, 4}, "ｷｬｯﾄ 3/3\nラーメン 4/4\n", true},
+}
+
+func TestCmdHaikuCheck(t *testing.T) {
+	for i, tt := range cmdhaikuchecktests {
+		var buf bytes.Buffer
+		ok := check(&buf, tt.poem, tt.form)
+		if out := buf.String(); out != tt.out || ok != tt.ok {
+			t.Errorf("#%d check(buf, %q, %v)\n\thave: %q, %v\n\twant: %q, %v",
+				i, tt.poem, tt.form, out, ok, tt.out, tt.ok)
+		}
+	}
+}
+
+type CmdHaikuReadWriteTest struct {
+	in   string
+	out  string
+	form []int
+	ok   bool
+}
+
+var cmdhaikureadwritetests = []CmdHaikuReadWriteTest{
+	0: {"testdata/haiku_in01.txt", "testdata/haiku_out01.txt", haikuMorae, true},
+	1: {"testdata/haiku_in02.txt", "testdata/haiku_out02.txt", haikuMorae, false},
+	2: {"testdata/haiku_in03.txt", "testdata/haiku_out03.txt", tankaMorae, true},
+}
+
+func TestCmdHaikuReadWrite(t *testing.T) {
+	for i, tt := range cmdhaikureadwritetests {
+		want, err := ioutil.ReadFile(tt.out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// Supports Windows environment where git config core.autocrlf = true
+		wantS := strings.Replace(string(want), "\r", "", -1)
+
+		ss, err := readfiles([]string{tt.in})
+		if err != nil {
+			log.Fatal(err)
+		}
+		var buf bytes.Buffer
+		ok := checkstrs(&buf, ss, tt.form)
+		if have := buf.String(); have != wantS || ok != tt.ok {
+			t.Errorf("#%d\nhave: %v\n%s\nwant: %v\n%s", i, ok, have, tt.ok, wantS)
+		}
+	}
+}
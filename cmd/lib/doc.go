@@ -0,0 +1,12 @@
+/*
+Package lib implements the utilities shared by gaga's commands.
+
+Features:
+	read the text in UTF-8, Shift_JIS, EUC-JP or ISO-2022-JP.
+	detect the encoding of the text.
+	write the text in UTF-8, Shift_JIS, EUC-JP or ISO-2022-JP,
+	with the line and column of the runes that cannot be represented.
+	check the runes that cannot be represented before the text is
+	transformed, to report their positions in the input.
+*/
+package lib
@@ -0,0 +1,212 @@
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+)
+
+// Auto is the name of the pseudo encoding that detects the encoding
+// of the input.
+const Auto = "auto"
+
+type encodingInfo struct {
+	name string            // the canonical name
+	enc  encoding.Encoding // nil for UTF-8
+}
+
+var (
+	utf8Info      = encodingInfo{"UTF-8", nil}
+	shiftJISInfo  = encodingInfo{"Shift_JIS", japanese.ShiftJIS}
+	eucJPInfo     = encodingInfo{"EUC-JP", japanese.EUCJP}
+	iso2022JPInfo = encodingInfo{"ISO-2022-JP", japanese.ISO2022JP}
+)
+
+var encodingMap = map[string]encodingInfo{
+	"utf-8":       utf8Info,
+	"utf8":        utf8Info,
+	"shift_jis":   shiftJISInfo,
+	"shift-jis":   shiftJISInfo,
+	"sjis":        shiftJISInfo,
+	"euc-jp":      eucJPInfo,
+	"eucjp":       eucJPInfo,
+	"iso-2022-jp": iso2022JPInfo,
+	"jis":         iso2022JPInfo,
+}
+
+func lookupEncoding(name string) (encodingInfo, error) {
+	info, ok := encodingMap[strings.ToLower(name)]
+	if !ok {
+		return encodingInfo{}, fmt.Errorf("unknown encoding: %q", name)
+	}
+	return info, nil
+}
+
+// decodeScore returns the number of invalid sequences and full-width
+// kana in the b decoded with the enc. Shift_JIS misread as EUC-JP
+// usually gives invalid sequences, and EUC-JP misread as Shift_JIS
+// gives half-width katakana and kanji instead of hiragana.
+func decodeScore(b []byte, enc encoding.Encoding) (invalid, kana int) {
+	dec, err := enc.NewDecoder().Bytes(b)
+	if err != nil {
+		return len(b), 0
+	}
+	for _, r := range string(dec) {
+		switch {
+		case r == utf8.RuneError:
+			invalid++
+		case 0x3041 <= r && r <= 0x30FF:
+			kana++
+		}
+	}
+	return
+}
+
+// DetectEncoding returns the canonical name of the encoding of the b,
+// which is one of "UTF-8", "Shift_JIS", "EUC-JP" and "ISO-2022-JP".
+// The b in ASCII is detected as UTF-8, and the b that is ambiguous
+// between Shift_JIS and EUC-JP is detected as Shift_JIS.
+func DetectEncoding(b []byte) string {
+	if bytes.Contains(b, []byte("\x1b$B")) || bytes.Contains(b, []byte("\x1b$@")) {
+		return iso2022JPInfo.name
+	}
+	if utf8.Valid(b) {
+		return utf8Info.name
+	}
+	sjisInvalid, sjisKana := decodeScore(b, shiftJISInfo.enc)
+	eucInvalid, eucKana := decodeScore(b, eucJPInfo.enc)
+	if eucInvalid < sjisInvalid || (eucInvalid == sjisInvalid && eucKana > sjisKana) {
+		return eucJPInfo.name
+	}
+	return shiftJISInfo.name
+}
+
+// NewReader returns a reader that decodes the r from the encoding of
+// the name into UTF-8. If the name is Auto, NewReader reads the whole r
+// to detect its encoding.
+func NewReader(r io.Reader, name string) (io.Reader, error) {
+	if strings.EqualFold(name, Auto) {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		r, name = bytes.NewReader(b), DetectEncoding(b)
+	}
+	info, err := lookupEncoding(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.enc == nil {
+		return r, nil
+	}
+	return transform.NewReader(r, info.enc.NewDecoder()), nil
+}
+
+// Unencodable is a rune that cannot be represented in an encoding.
+type Unencodable struct {
+	Offset int  // byte offset of the rune in the text
+	Line   int  // line number, starting at 1
+	Col    int  // column number in runes, starting at 1
+	Rune   rune // the rune
+}
+
+// EncodeError is the error returned by Encode and Checker, which lists
+// every rune that cannot be represented in the encoding.
+type EncodeError struct {
+	Encoding string // the canonical name of the encoding
+	Runes    []Unencodable
+}
+
+// Error returns a line for each rune in the form of
+// "2:5: U+20AC '€' cannot be represented in Shift_JIS".
+func (e *EncodeError) Error() string {
+	ss := make([]string, len(e.Runes))
+	for i, u := range e.Runes {
+		ss[i] = fmt.Sprintf("%d:%d: %U %q cannot be represented in %s",
+			u.Line, u.Col, u.Rune, u.Rune, e.Encoding)
+	}
+	return strings.Join(ss, "\n")
+}
+
+// Checker checks whether the runes can be represented in an encoding,
+// so that the commands can report the positions in their input rather
+// than in their output, such as the vertical layout of cmd/vert.
+type Checker struct {
+	info      encodingInfo
+	encodable map[rune]bool
+}
+
+// NewChecker returns a Checker for the encoding of the name.
+func NewChecker(name string) (*Checker, error) {
+	info, err := lookupEncoding(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Checker{info, make(map[rune]bool)}, nil
+}
+
+// Encoding returns the canonical name of the encoding.
+func (c *Checker) Encoding() string {
+	return c.info.name
+}
+
+// Encodable reports whether the r can be represented in the encoding.
+func (c *Checker) Encodable(r rune) bool {
+	if c.info.enc == nil {
+		return true
+	}
+	ok, checked := c.encodable[r]
+	if !checked {
+		// A rune is checked with a new encoder, since the encoder of
+		// ISO-2022-JP has a state that depends on the preceding runes.
+		_, err := c.info.enc.NewEncoder().String(string(r))
+		ok = err == nil
+		c.encodable[r] = ok
+	}
+	return ok
+}
+
+// Check returns an *EncodeError that lists the runes of the s that
+// cannot be represented in the encoding, with their positions in the s,
+// or nil if there is no such rune.
+func (c *Checker) Check(s string) error {
+	var runes []Unencodable
+	line, col := 1, 1
+	for i, r := range s {
+		if !c.Encodable(r) {
+			runes = append(runes, Unencodable{i, line, col, r})
+		}
+		if r == '\n' {
+			line, col = line+1, 1
+		} else {
+			col++
+		}
+	}
+	if len(runes) > 0 {
+		return &EncodeError{c.info.name, runes}
+	}
+	return nil
+}
+
+// Encode encodes the s from UTF-8 into the encoding of the name. If the
+// s has runes that cannot be represented in the encoding, Encode returns
+// an *EncodeError and no output.
+func Encode(s string, name string) ([]byte, error) {
+	c, err := NewChecker(name)
+	if err != nil {
+		return nil, err
+	}
+	if c.info.enc == nil {
+		return []byte(s), nil
+	}
+	if err := c.Check(s); err != nil {
+		return nil, err
+	}
+	return c.info.enc.NewEncoder().Bytes([]byte(s))
+}
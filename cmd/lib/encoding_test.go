@@ -0,0 +1,128 @@
+package lib
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type EncodingTest struct {
+	name string
+	in   string
+	out  string
+}
+
+var encodingtests = []EncodingTest{
+	0: {"UTF-8", "abc", "abc"},
+	1: {"UTF-8", "閑さや", "閑さや"},
+	2: {"Shift_JIS", "閑さや", "\x8a\xd5\x82\xb3\x82\xe2"},
+	3: {"Shift_JIS", "ｶﾞ", "\xb6\xde"},
+	4: {"Shift_JIS", "ｶﾞｲﾄﾞです", "\xb6\xde\xb2\xc4\xde\x82\xc5\x82\xb7"},
+	5: {"EUC-JP", "閑さや", "\xb4\xd7\xa4\xb5\xa4\xe4"},
+	6: {"EUC-JP", "ｶﾞｲﾄﾞです", "\x8e\xb6\x8e\xde\x8e\xb2\x8e\xc4\x8e\xde\xa4\xc7\xa4\xb9"},
+	7: {"EUC-JP", "岩にしみ入る蝉の声", "\xb4\xe4\xa4\xcb\xa4\xb7\xa4\xdf\xc6\xfe\xa4\xeb\xc0\xe6\xa4\xce\xc0\xbc"},
+	8: {"ISO-2022-JP", "a閑さやb", "a\x1b$B4W$5$d\x1b(Bb"},
+}
+
+func TestEncode(t *testing.T) {
+	for i, tt := range encodingtests {
+		have, err := Encode(tt.in, tt.name)
+		if err != nil {
+			t.Errorf("#%d Encode(%q, %s): %s", i, tt.in, tt.name, err.Error())
+			continue
+		}
+		if string(have) != tt.out {
+			t.Errorf("#%d Encode(%q, %s) = %q, want: %q", i, tt.in, tt.name, have, tt.out)
+		}
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	for i, tt := range encodingtests {
+		for _, name := range []string{tt.name, Auto} {
+			r, err := NewReader(strings.NewReader(tt.out), name)
+			if err != nil {
+				t.Errorf("#%d NewReader(%q, %s): %s", i, tt.out, name, err.Error())
+				continue
+			}
+			have, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Errorf("#%d NewReader(%q, %s): %s", i, tt.out, name, err.Error())
+				continue
+			}
+			if string(have) != tt.in {
+				t.Errorf("#%d NewReader(%q, %s) = %q, want: %q", i, tt.out, name, have, tt.in)
+			}
+		}
+	}
+}
+
+func TestDetectEncoding(t *testing.T) {
+	for i, tt := range encodingtests {
+		if have := DetectEncoding([]byte(tt.out)); have != tt.name {
+			t.Errorf("#%d DetectEncoding(%q) = %s, want: %s", i, tt.out, have, tt.name)
+		}
+	}
+}
+
+func TestUnknownEncoding(t *testing.T) {
+	for _, name := range []string{"", "utf-16", Auto} {
+		if _, err := Encode("a", name); err == nil {
+			t.Errorf("Encode(%q, %q) = nil, want: error", "a", name)
+		}
+	}
+	for _, name := range []string{"", "utf-16"} {
+		if _, err := NewReader(strings.NewReader("a"), name); err == nil {
+			t.Errorf("NewReader(%q, %q) = nil, want: error", "a", name)
+		}
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	in := "€あ\nい😀Ⅻ\n"
+	_, err := Encode(in, "Shift_JIS")
+	e, ok := err.(*EncodeError)
+	if !ok {
+		t.Fatalf("Encode(%q, Shift_JIS) = %v, want: *EncodeError", in, err)
+	}
+	want := "1:1: U+20AC '€' cannot be represented in Shift_JIS\n" +
+		"2:2: U+1F600 '😀' cannot be represented in Shift_JIS\n" +
+		"2:3: U+216B 'Ⅻ' cannot be represented in Shift_JIS"
+	if have := e.Error(); have != want {
+		t.Errorf("Encode(%q, Shift_JIS)\nhave:\n%s\nwant:\n%s", in, have, want)
+	}
+	if _, err := Encode(in, "UTF-8"); err != nil {
+		t.Errorf("Encode(%q, UTF-8): %s", in, err.Error())
+	}
+}
+
+func TestChecker(t *testing.T) {
+	c, err := NewChecker("sjis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := c.Encoding(); have != "Shift_JIS" {
+		t.Errorf("Encoding() = %s, want: Shift_JIS", have)
+	}
+	for _, r := range "aあ閑ｶ" {
+		if !c.Encodable(r) {
+			t.Errorf("Encodable(%#U) = false, want: true", r)
+		}
+	}
+	in := "あ€\n😀"
+	e, ok := c.Check(in).(*EncodeError)
+	if !ok {
+		t.Fatalf("Check(%q) = %v, want: *EncodeError", in, c.Check(in))
+	}
+	want := []Unencodable{{3, 1, 2, '€'}, {7, 2, 1, '😀'}}
+	if !reflect.DeepEqual(e.Runes, want) {
+		t.Errorf("Check(%q) = %v, want: %v", in, e.Runes, want)
+	}
+	if err := c.Check("あい\n"); err != nil {
+		t.Errorf("Check(%q) = %v, want: nil", "あい\n", err)
+	}
+	if _, err := NewChecker("utf-16"); err == nil {
+		t.Errorf("NewChecker(%q) = nil, want: error", "utf-16")
+	}
+}
@@ -14,6 +14,24 @@ The flags are:
 		Show help of the normalization flags
 	-flag string
 		Normalization flag (default "Fold")
+	-rules string
+		Custom normalization rule file, see gaga.ParseRules
+	-explain
+		List the changes with their line, column and responsible
+		flags instead of the normalized text
+	-from string
+		Encoding of input: utf-8, shift_jis, euc-jp, iso-2022-jp or
+		auto to detect it (default "utf-8")
+	-to string
+		Encoding of output: utf-8, shift_jis, euc-jp or iso-2022-jp
+		(default "utf-8"). The runes that cannot be represented are
+		reported with their line and column in the input, and nothing
+		is written
+	-check-charset string
+		List the characters out of the character set (JISX0208,
+		JISX0213, CP932 or Zengin) with their line, column and
+		suggested replacement instead of the normalized text, and
+		exit with status 1 if any is found
 
 Examples:
 
@@ -27,6 +45,32 @@ To read standard input:
 	$ echo "ＡＢＣｱｲｳ" | norm -flag "AlphaToNarrow|AlphaToLower|KanaToHiragana"
 	abcあいう
 
+To list the changes:
+	$ echo "ＡＢｶﾞ" | norm -explain
+	1:1: U+FF21 -> U+0041 (AlphaToNarrow)
+	1:2: U+FF22 -> U+0042 (AlphaToNarrow)
+	1:3: U+FF76 U+FF9E -> U+30AC (KatakanaToWide|ComposeVom)
+
+To apply the custom rules:
+	$ cat rules.txt
+	# WAVE DASH to TILDE
+	U+301C -> ～
+	㈱ -> (株)
+	$ echo "ＡＢ〜㈱" | norm -rules rules.txt
+	AB~(株)
+
+To check the characters for a character set:
+	$ echo "ＡＢｶﾞ①😀" | norm -check-charset JISX0208
+	1:3: U+FF76 U+FF9E "ｶﾞ" -> "ガ"
+	1:5: U+2460 "①" -> "1"
+	1:6: U+1F600 "😀"
+
+To read and write the legacy encodings:
+	$ norm -from auto -to shift_jis sjis.txt > out.txt
+
+	$ echo "ＡＢ€" | norm -to shift_jis
+	1:3: U+20AC '€' cannot be represented in Shift_JIS
+
 If you have the following files,
 	$ cat basho_en.txt
 	--Keene, Narrow Road 99
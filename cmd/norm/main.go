@@ -2,13 +2,17 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
 	"github.com/y-bash/go-gaga"
+	"github.com/y-bash/go-gaga/cmd/lib"
 	"io"
 	"log"
 	"os"
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 var version = "v0.0.0" // set value by go build -ldflags
@@ -24,9 +28,14 @@ func read(f io.Reader) string {
 	return sb.String()
 }
 
-func readfiles(paths []string) (out []string, err error) {
+func readfiles(paths []string, from string) (out []string, err error) {
+	var r io.Reader
 	if len(paths) == 0 {
-		out = []string{read(os.Stdin)}
+		r, err = lib.NewReader(os.Stdin, from)
+		if err != nil {
+			return
+		}
+		out = []string{read(r)}
 		return
 	}
 	for _, path := range paths {
@@ -36,16 +45,36 @@ func readfiles(paths []string) (out []string, err error) {
 			return
 		}
 		defer f.Close()
-		out = append(out, read(f))
+		r, err = lib.NewReader(f, from)
+		if err != nil {
+			return
+		}
+		out = append(out, read(r))
 	}
 	return
 }
 
-func normstrs(f io.Writer, in []string, flag gaga.NormFlag) error {
+func readrules(path string) (gaga.Rules, error) {
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rules, err := gaga.ParseRules(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return rules, nil
+}
+
+func normstrs(f io.Writer, in []string, flag gaga.NormFlag, rules gaga.Rules) error {
 	if len(in) <= 0 {
 		return nil
 	}
-	n, err := gaga.Norm(flag)
+	n, err := gaga.NormWithRules(flag, rules)
 	if err != nil {
 		return err
 	}
@@ -57,13 +86,147 @@ func normstrs(f io.Writer, in []string, flag gaga.NormFlag) error {
 	return nil
 }
 
+// position returns the line and column number (in runes) of the byte
+// offset off in the s, both starting at 1.
+func position(s string, off int) (line, col int) {
+	line = 1 + strings.Count(s[:off], "\n")
+	col = 1 + utf8.RuneCountInString(s[strings.LastIndex(s[:off], "\n")+1:off])
+	return
+}
+
+func explainstrs(f io.Writer, in []string, flag gaga.NormFlag, rules gaga.Rules) error {
+	n, err := gaga.NormWithRules(flag, rules)
+	if err != nil {
+		return err
+	}
+	for i, s := range in {
+		if i > 0 {
+			fmt.Fprintln(f)
+		}
+		for _, c := range n.Explain(s) {
+			line, col := position(s, c.Offset)
+			fmt.Fprintf(f, "%d:%d: %s\n", line, col, c)
+		}
+	}
+	return nil
+}
+
+// checkstrs lists the characters of the in that are not in the cs, and
+// returns the number of them.
+func checkstrs(f io.Writer, in []string, cs gaga.Charset) int {
+	count := 0
+	for i, s := range in {
+		if i > 0 {
+			fmt.Fprintln(f)
+		}
+		for _, v := range gaga.Check(s, cs) {
+			fmt.Fprintf(f, "%d:%d: %s\n", v.Line, v.Col, v)
+			count++
+		}
+	}
+	return count
+}
+
+// unencodables collects the runes that cannot be represented in the
+// encoding of the output, with their positions in the input.
+type unencodables struct {
+	c     *lib.Checker
+	runes []lib.Unencodable
+	seen  map[lib.Unencodable]bool // the runes of the current text
+	start int                      // the index of the first rune of the current text
+}
+
+// add adds the r if the encoding cannot represent it, at the byte offset
+// off in the s.
+func (u *unencodables) add(s string, off int, r rune) {
+	if u.c.Encodable(r) {
+		return
+	}
+	line, col := position(s, off)
+	e := lib.Unencodable{Offset: off, Line: line, Col: col, Rune: r}
+	if !u.seen[e] {
+		u.seen[e] = true
+		u.runes = append(u.runes, e)
+	}
+}
+
+// normalized adds the runes of the s normalized by the n, at the
+// positions of the characters of the s that they come from.
+func (u *unencodables) normalized(s string, n *gaga.Normalizer) {
+	out, a := n.StringWithOffsets(s)
+	for i, r := range out {
+		start, _ := a.SourceRange(i, i+utf8.RuneLen(r))
+		u.add(s, start, r)
+	}
+}
+
+// done sorts the runes of the current text in the order of the
+// positions, and starts the next text.
+func (u *unencodables) done() {
+	runes := u.runes[u.start:]
+	sort.SliceStable(runes, func(i, j int) bool { return runes[i].Offset < runes[j].Offset })
+	u.seen = make(map[lib.Unencodable]bool)
+	u.start = len(u.runes)
+}
+
+func (u *unencodables) err() error {
+	if len(u.runes) > 0 {
+		return &lib.EncodeError{Encoding: u.c.Encoding(), Runes: u.runes}
+	}
+	return nil
+}
+
+// checkencoding returns an *lib.EncodeError that lists the runes of the
+// text that normstrs, explainstrs or checkstrs writes for the in, which
+// cannot be represented in the encoding of the name. The positions refer
+// to the in rather than to the text written.
+func checkencoding(in []string, name string, flag gaga.NormFlag, rules gaga.Rules, x bool, cs *gaga.Charset) error {
+	c, err := lib.NewChecker(name)
+	if err != nil {
+		return err
+	}
+	u := &unencodables{c: c, seen: make(map[lib.Unencodable]bool)}
+	switch {
+	case x && cs == nil:
+		// explainstrs writes the changes in ASCII only, such as
+		// "U+FF76 -> U+30AB (KatakanaToWide)".
+	case cs != nil:
+		for _, s := range in {
+			for _, v := range gaga.Check(s, *cs) {
+				for _, r := range v.Src {
+					u.add(s, v.Offset, r)
+				}
+				for _, r := range v.Suggest {
+					u.add(s, v.Offset, r)
+				}
+			}
+			u.done()
+		}
+	default:
+		n, err := gaga.NormWithRules(flag, rules)
+		if err != nil {
+			return err
+		}
+		for _, s := range in {
+			u.normalized(s, n)
+			u.done()
+		}
+	}
+	return u.err()
+}
+
 func main() {
-	var v, h, f bool
-	var normflag string
+	var v, h, f, x bool
+	var normflag, rulefile, from, to, charset string
 	flag.BoolVar(&v, "v", false, "show version")
 	flag.BoolVar(&h, "h", false, "show help")
 	flag.BoolVar(&f, "f", false, "show help of the normalization flags")
 	flag.StringVar(&normflag, "flag", "Fold", "normalization flag")
+	flag.StringVar(&rulefile, "rules", "", "custom normalization rule file")
+	flag.BoolVar(&x, "explain", false, "list the changes instead of the normalized text")
+	flag.StringVar(&from, "from", "utf-8", "encoding of input (utf-8, shift_jis, euc-jp, iso-2022-jp or auto)")
+	flag.StringVar(&to, "to", "utf-8", "encoding of output (utf-8, shift_jis, euc-jp or iso-2022-jp)")
+	flag.StringVar(&charset, "check-charset", "", "list the characters out of the charset (JISX0208, JISX0213, CP932 or Zengin) instead of the normalized text")
 	flag.Parse()
 	if v {
 		fmt.Println("version:", version)
@@ -81,15 +244,50 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	rules, err := readrules(rulefile)
+	if err != nil {
+		log.Fatal(err)
+	}
 	var ss []string
-	ss, err = readfiles(flag.Args())
+	ss, err = readfiles(flag.Args(), from)
 	if err != nil {
 		log.Fatal(err)
 	}
-	err = normstrs(os.Stdout, ss, nf)
+	var cs *gaga.Charset
+	if charset != "" {
+		var c gaga.Charset
+		c, err = gaga.ParseCharset(charset)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cs = &c
+	}
+	err = checkencoding(ss, to, nf, rules, x, cs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var buf bytes.Buffer
+	violations := 0
+	if cs != nil {
+		violations = checkstrs(&buf, ss, *cs)
+	} else if x {
+		err = explainstrs(&buf, ss, nf, rules)
+	} else {
+		err = normstrs(&buf, ss, nf, rules)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
+	out, err := lib.Encode(buf.String(), to)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(out)
+	if violations > 0 {
+		os.Exit(1)
+	}
 }
 
 const flaghelp = `The normalization flags of the Norm command.
@@ -434,4 +632,132 @@ IsolatedVomToNonspace
 
     Examples:
         [゛] => [U+3099],  [ﾞ] => [U+3099],  [゜] = [U+309A],  [ﾟ] => [U+309A]
+
+ProlongedSoundMarkByContext
+    Description:
+        ProlongedSoundMarkByContext converts a dash-like character into
+        a prolonged sound mark after a kana letter, or into a hyphen-minus
+        between Latin letters or digits, according to its neighbours.
+        The width follows the letter that precedes it.
+
+    Examples:
+        [カ][－][ド] => [カ][ー][ド],  [ｶ][-][ﾄ][ﾞ] => [ｶ][ｰ][ﾄ][ﾞ],
+        [0][3][ー][1] => [0][3][-][1],  [０][３][ー][１] => [０][３][－][１]
+
+SmallKanaToLarge
+    Description:
+        SmallKanaToLarge converts the small Hiragana-Katakana letters
+        to their large ones.
+
+    Examples:
+        [ッ] => [ツ],  [ぁ] => [あ],  [ｯ] => [ﾂ],  [ㇰ] => [ク]
+
+StripVoicing
+    Description:
+        StripVoicing converts the voiced or semi-voiced sound letters to
+        their unvoiced ones, and removes the voicing modifiers, which
+        takes precedence over the IsolatedVomTo flags.
+
+    Examples:
+        [が] => [か],  [パ] => [ハ],  [ｶ][ﾞ] => [ｶ],  [か][゛] => [か],
+        [゛] => [],    [U+3099] => []
+
+ExpandIterationMark
+    Description:
+        ExpandIterationMark replaces an iteration mark with the letter
+        that it repeats. The [ゝ] and [ヽ] repeat the unvoiced letter, and
+        the [ゞ] and [ヾ] repeat the voiced one.
+
+    Examples:
+        [い][す][ゞ] => [い][す][ず],  [カ][ヾ] => [カ][ガ],
+        [こ][ゝ][ろ] => [こ][こ][ろ],  [時][々] => [時][時]
+
+CollapseIterationMark
+    Description:
+        CollapseIterationMark replaces a letter that repeats the preceding
+        one with an iteration mark, which is the reverse of the
+        ExpandIterationMark.
+
+    Examples:
+        [い][す][ず] => [い][す][ゞ],  [カ][ガ] => [カ][ヾ],
+        [こ][こ][ろ] => [こ][ゝ][ろ],  [時][時] => [時][々]
+
+ModernizeKana
+    Description:
+        ModernizeKana converts the historical or obsolete kana letters to
+        their modern ones. The letters [ヷ][ヸ][ヹ][ヺ] are converted to
+        [ヴ][ァ] etc. unless the ModernizeVaToBa is also specified.
+
+    Examples:
+        [ゐ] => [い],     [ヱ] => [エ],     [ヷ] => [ヴ][ァ],  [ヺ] => [ヴ][ォ],
+        [ㇰ] => [ク],     [ㇷ] => [フ],     [ゟ] => [よ][り],  [ヿ] => [コ][ト]
+
+ModernizeVaToBa
+    Description:
+        ModernizeVaToBa converts the letters [ヷ][ヸ][ヹ][ヺ] to
+        [バ][ビ][ベ][ボ].
+
+    Examples:
+        [ヷ] => [バ],  [ヸ] => [ビ],  [ヹ] => [ベ],  [ヺ] => [ボ]
+
+EnclosedToPlain
+    Description:
+        EnclosedToPlain expands the enclosed or parenthesized characters
+        to their plain characters, which are then normalized according to
+        the other flags, such as the LatinToNarrow.
+
+    Examples:
+        [①] => [1],  [⑴] => [(][1][)],  [⒈] => [1][.],
+        [㈱] => [(][株][)],  [㊤] => [上],  [Ⓐ] => [A]
+
+CompatToPlain
+    Description:
+        CompatToPlain expands the CJK compatibility characters, such as
+        the squared words, units and era names, and the letterlike symbols
+        to their plain spelling, which is then normalized according to the
+        other flags, such as the Fold.
+
+    Examples:
+        [㌔] => [キ][ロ],  [㍍] => [メ][ー][ト][ル],  [㍻] => [平][成],
+        [㍿] => [株][式][会][社],  [㎏] => [k][g],  [℡] => [T][E][L]
+
+KanjiVariantFold
+    Description:
+        KanjiVariantFold converts the CJK compatibility ideographs to
+        their unified ideographs, and the variant kanji (itaiji) commonly
+        used in names to their standard kanji.
+
+    Examples:
+        [髙] => [高],  [﨑] => [崎],  [德] => [徳],  [U+F9DC] => [隆]
+
+StripIVS
+    Description:
+        StripIVS removes the ideographic variation selectors
+        (U+E0100-U+E01EF), which is usually specified together with the
+        KanjiVariantFold.
+
+    Examples:
+        [葛][U+E0100] => [葛],  [辻][U+E0101] => [辻]
+
+CP932ToJIS
+    Description:
+        CP932ToJIS converts the characters that Microsoft's mapping of
+        Shift_JIS (CP932) gives to those that the JIS mapping gives for
+        the same codes. These characters are not converted by the width
+        flags, but the SymbolToWide gives the JIS ones, except that [-] is
+        widened to [－].
+
+    Examples:
+        [～] => [〜],  [－] => [−],  [∥] => [‖],
+        [￠] => [¢],   [￡] => [£],  [￢] => [¬],  [~] => [〜] (with SymbolToWide)
+
+JISToCP932
+    Description:
+        JISToCP932 converts the characters that the JIS mapping of
+        Shift_JIS gives to those that Microsoft's mapping (CP932) gives for
+        the same codes, which is the reverse of the CP932ToJIS.
+
+    Examples:
+        [〜] => [～],  [−] => [－],  [‖] => [∥],
+        [¢] => [￠],   [£] => [￡],  [¬] => [￢]
 `
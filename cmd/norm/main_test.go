@@ -13,11 +13,17 @@ type CmdNormReadWriteTest struct {
 	in   string
 	out  string
 	flag gaga.NormFlag
+	from string
 }
 
 var cmdnormreadwritetests = []CmdNormReadWriteTest{
-	0: {"testdata/norm_in01.txt", "testdata/norm_out01.txt", gaga.HiraganaToKatakana},
-	1: {"testdata/norm_in02.txt", "testdata/norm_out02.txt", gaga.LatinToWide | gaga.AlphaToUpper},
+	0: {"testdata/norm_in01.txt", "testdata/norm_out01.txt", gaga.HiraganaToKatakana, "utf-8"},
+	1: {"testdata/norm_in02.txt", "testdata/norm_out02.txt", gaga.LatinToWide | gaga.AlphaToUpper, "utf-8"},
+	2: {"testdata/norm_in01.txt", "testdata/norm_out01.txt", gaga.HiraganaToKatakana, "auto"},
+	3: {"testdata/norm_in03.txt", "testdata/norm_out03.txt", gaga.Fold, "shift_jis"},
+	4: {"testdata/norm_in03.txt", "testdata/norm_out03.txt", gaga.Fold, "auto"},
+	5: {"testdata/norm_in04.txt", "testdata/norm_out03.txt", gaga.Fold, "euc-jp"},
+	6: {"testdata/norm_in04.txt", "testdata/norm_out03.txt", gaga.Fold, "auto"},
 }
 
 func TestCmdNormReadWrite(t *testing.T) {
@@ -30,11 +36,11 @@ func TestCmdNormReadWrite(t *testing.T) {
 		wantS := strings.Replace(string(want), "\r", "", -1)
 
 		var buf bytes.Buffer
-		ss, err := readfiles([]string{tt.in})
+		ss, err := readfiles([]string{tt.in}, tt.from)
 		if err != nil {
 			log.Fatal(err)
 		}
-		err = normstrs(&buf, ss, tt.flag)
+		err = normstrs(&buf, ss, tt.flag, nil)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -47,3 +53,115 @@ func TestCmdNormReadWrite(t *testing.T) {
 		}
 	}
 }
+
+type CmdNormExplainTest struct {
+	in   []string
+	out  string
+	flag gaga.NormFlag
+}
+
+var cmdnormexplaintests = []CmdNormExplainTest{
+	0: {[]string{"abc\n"}, "", gaga.Fold},
+	1: {[]string{"ＡＢｶﾞ\n"}, "1:1: U+FF21 -> U+0041 (AlphaToNarrow)\n" +
+		"1:2: U+FF22 -> U+0042 (AlphaToNarrow)\n" +
+		"1:3: U+FF76 U+FF9E -> U+30AC (KatakanaToWide|ComposeVom)\n", gaga.Fold},
+	2: {[]string{"あ\nいアｲ\n", "ア\n"}, "2:2: U+30A2 -> U+3042 (KatakanaToHiragana)\n" +
+		"2:3: U+FF72 -> U+3044 (KatakanaToHiragana)\n" +
+		"\n" +
+		"1:1: U+30A2 -> U+3042 (KatakanaToHiragana)\n", gaga.KanaToHiragana},
+}
+
+func TestCmdNormExplain(t *testing.T) {
+	for i, tt := range cmdnormexplaintests {
+		var buf bytes.Buffer
+		err := explainstrs(&buf, tt.in, tt.flag, nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if have := buf.String(); have != tt.out {
+			t.Errorf("#%d\nhave:\n%s\nwant:\n%s", i, have, tt.out)
+		}
+	}
+}
+
+func TestCmdNormRules(t *testing.T) {
+	rules, err := readrules("testdata/norm_rules.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = normstrs(&buf, []string{"ＡＢ〜ＣＤ−㈱\n"}, gaga.Fold, rules)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if have, want := buf.String(), "AB~CD-(株)\n"; have != want {
+		t.Errorf("have: %q, want: %q", have, want)
+	}
+}
+
+type CmdNormEncodeTest struct {
+	in  string
+	x   bool
+	cs  *gaga.Charset
+	out string
+}
+
+var zengin = gaga.Zengin
+
+var cmdnormencodetests = []CmdNormEncodeTest{
+	0: {"ＡＢ😀\n€ｶﾞ\n", false, nil,
+		"1:3: U+1F600 '😀' cannot be represented in Shift_JIS\n" +
+			"2:1: U+20AC '€' cannot be represented in Shift_JIS"},
+	// The positions refer to the input, in which [ｶﾞ] is two runes.
+	1: {"ｶﾞｶﾞ😀\n", false, nil,
+		"1:5: U+1F600 '😀' cannot be represented in Shift_JIS"},
+	2: {"ｶﾞ\n", true, nil, ""},
+	3: {"ｶﾞ😀€\n", true, nil, ""},
+	4: {"ｶﾞ€\n", false, &zengin,
+		"1:3: U+20AC '€' cannot be represented in Shift_JIS"},
+}
+
+func TestCmdNormEncode(t *testing.T) {
+	for i, tt := range cmdnormencodetests {
+		if err := checkencoding([]string{tt.in}, "utf-8", gaga.Fold, nil, tt.x, tt.cs); err != nil {
+			t.Errorf("#%d checkencoding(%q, utf-8): %s", i, tt.in, err.Error())
+		}
+		err := checkencoding([]string{tt.in}, "shift_jis", gaga.Fold, nil, tt.x, tt.cs)
+		if tt.out == "" {
+			if err != nil {
+				t.Errorf("#%d checkencoding(%q, shift_jis) = %v, want: nil", i, tt.in, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.out {
+			t.Errorf("#%d checkencoding(%q, shift_jis)\nhave:\n%v\nwant:\n%s", i, tt.in, err, tt.out)
+		}
+	}
+}
+
+type CmdNormCheckTest struct {
+	in    []string
+	out   string
+	count int
+	cs    gaga.Charset
+}
+
+var cmdnormchecktests = []CmdNormCheckTest{
+	0: {[]string{"abc\n"}, "", 0, gaga.JISX0208},
+	1: {[]string{"ＡＢｶﾞ\n①😀\n"}, "1:3: U+FF76 U+FF9E \"ｶﾞ\" -> \"ガ\"\n" +
+		"2:1: U+2460 \"①\" -> \"1\"\n" +
+		"2:2: U+1F600 \"😀\"\n", 3, gaga.JISX0208},
+	2: {[]string{"ﾔﾏﾀﾞ ﾀﾛｳ\n", "ﾔﾏﾀﾞ ﾊﾅｺ\nｷｬｯﾄ\n"}, "\n" +
+		"2:2: U+FF6C \"ｬ\" -> \"ﾔ\"\n" +
+		"2:3: U+FF6F \"ｯ\" -> \"ﾂ\"\n", 2, gaga.Zengin},
+}
+
+func TestCmdNormCheck(t *testing.T) {
+	for i, tt := range cmdnormchecktests {
+		var buf bytes.Buffer
+		count := checkstrs(&buf, tt.in, tt.cs)
+		if have := buf.String(); have != tt.out || count != tt.count {
+			t.Errorf("#%d\nhave(%d):\n%s\nwant(%d):\n%s", i, count, have, tt.count, tt.out)
+		}
+	}
+}
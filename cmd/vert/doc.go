@@ -14,6 +14,14 @@ The flags are:
 		Maximum width of output (default: 40)
 	-height
 		Maximum height of output (default: 25)
+	-from
+		Encoding of input: utf-8, shift_jis, euc-jp, iso-2022-jp or
+		auto to detect it (default: utf-8)
+	-to
+		Encoding of output: utf-8, shift_jis, euc-jp or iso-2022-jp
+		(default: utf-8). The runes that cannot be represented are
+		reported with their line and column in the input, and nothing
+		is written
 
 Examples:
 
@@ -43,6 +51,9 @@ To read this file:
 	      入
 	      る
 
+To read the file in EUC-JP and write in Shift_JIS:
+	$ vert -from euc-jp -to shift_jis basho_euc.txt > basho_sjis.txt
+
 To limit the height:
 	$ vert -height 4 basho.txt
 	芭  蝉入岩閑
@@ -2,9 +2,11 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
 	"github.com/y-bash/go-gaga"
+	"github.com/y-bash/go-gaga/cmd/lib"
 	"io"
 	"log"
 	"os"
@@ -24,9 +26,14 @@ func read(f io.Reader) string {
 	return sb.String()
 }
 
-func readfiles(paths []string) (out []string, err error) {
+func readfiles(paths []string, from string) (out []string, err error) {
+	var r io.Reader
 	if len(paths) == 0 {
-		out = []string{read(os.Stdin)}
+		r, err = lib.NewReader(os.Stdin, from)
+		if err != nil {
+			return
+		}
+		out = []string{read(r)}
 		return
 	}
 	for _, path := range paths {
@@ -36,7 +43,11 @@ func readfiles(paths []string) (out []string, err error) {
 			return
 		}
 		defer f.Close()
-		out = append(out, read(f))
+		r, err = lib.NewReader(f, from)
+		if err != nil {
+			return
+		}
+		out = append(out, read(r))
 	}
 	return
 }
@@ -62,13 +73,32 @@ func vertstrs(f io.Writer, in []string, w, h int) {
 	}
 }
 
+// checkstrs returns an *lib.EncodeError that lists the runes of the in
+// that the c cannot encode, with their positions in the in, or nil if
+// there is no such rune.
+func checkstrs(c *lib.Checker, in []string) error {
+	var runes []lib.Unencodable
+	for _, s := range in {
+		if err := c.Check(s); err != nil {
+			runes = append(runes, err.(*lib.EncodeError).Runes...)
+		}
+	}
+	if len(runes) > 0 {
+		return &lib.EncodeError{Encoding: c.Encoding(), Runes: runes}
+	}
+	return nil
+}
+
 func main() {
 	var v, h bool
 	var width, height int
+	var from, to string
 	flag.BoolVar(&v, "v", false, "show version")
 	flag.BoolVar(&h, "h", false, "show help")
 	flag.IntVar(&width, "width", 40, "maximum width of output")
 	flag.IntVar(&height, "height", 25, "maximum height of output")
+	flag.StringVar(&from, "from", "utf-8", "encoding of input (utf-8, shift_jis, euc-jp, iso-2022-jp or auto)")
+	flag.StringVar(&to, "to", "utf-8", "encoding of output (utf-8, shift_jis, euc-jp or iso-2022-jp)")
 	flag.Parse()
 	if v {
 		fmt.Println("version:", version)
@@ -82,9 +112,26 @@ func main() {
 		flag.Usage()
 		os.Exit(2)
 	}
-	ss, err := readfiles(flag.Args())
+	ss, err := readfiles(flag.Args(), from)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// The input is checked before the layout, so that the positions of
+	// the runes that cannot be encoded refer to the input.
+	c, err := lib.NewChecker(to)
 	if err != nil {
 		log.Fatal(err)
 	}
-	vertstrs(os.Stdout, ss, width, height)
+	if err := checkstrs(c, ss); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var buf bytes.Buffer
+	vertstrs(&buf, ss, width, height)
+	out, err := lib.Encode(buf.String(), to)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Stdout.Write(out)
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"github.com/y-bash/go-gaga/cmd/lib"
 	"io/ioutil"
 	"log"
 	"strings"
@@ -76,15 +77,18 @@ func TestCmdVertStrs(t *testing.T) {
 }
 
 type CmdVertReadWrieTest struct {
-	in  string
-	out string
+	in   string
+	out  string
+	from string
 }
 
 var cmdvertreadwritetests = []CmdVertReadWrieTest{
-	0: {"testdata/vert_in01.txt", "testdata/vert_out01.txt"},
-	1: {"testdata/vert_in02.txt", "testdata/vert_out02.txt"},
-	2: {"testdata/vert_in03.txt", "testdata/vert_out03.txt"},
-	3: {"testdata/vert_in04.txt", "testdata/vert_out04.txt"},
+	0: {"testdata/vert_in01.txt", "testdata/vert_out01.txt", "utf-8"},
+	1: {"testdata/vert_in02.txt", "testdata/vert_out02.txt", "utf-8"},
+	2: {"testdata/vert_in03.txt", "testdata/vert_out03.txt", "utf-8"},
+	3: {"testdata/vert_in04.txt", "testdata/vert_out04.txt", "utf-8"},
+	4: {"testdata/vert_in05.txt", "testdata/vert_out01.txt", "euc-jp"},
+	5: {"testdata/vert_in05.txt", "testdata/vert_out01.txt", "auto"},
 }
 
 func TestCmdVertReadWrite(t *testing.T) {
@@ -97,7 +101,7 @@ func TestCmdVertReadWrite(t *testing.T) {
 		wantS := strings.Replace(string(want), "\r", "", -1)
 
 		var buf bytes.Buffer
-		ss, err := readfiles([]string{tt.in})
+		ss, err := readfiles([]string{tt.in}, tt.from)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -111,3 +115,21 @@ func TestCmdVertReadWrite(t *testing.T) {
 		}
 	}
 }
+
+func TestCmdVertCheckStrs(t *testing.T) {
+	c, err := lib.NewChecker("shift_jis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The positions refer to the input, not to the vertical layout.
+	ss := []string{"あい😀\nう€\n"}
+	err = checkstrs(c, ss)
+	want := "1:3: U+1F600 '😀' cannot be represented in Shift_JIS\n" +
+		"2:2: U+20AC '€' cannot be represented in Shift_JIS"
+	if err == nil || err.Error() != want {
+		t.Errorf("checkstrs(%q)\nhave:\n%v\nwant:\n%s", ss, err, want)
+	}
+	if err := checkstrs(c, []string{"あい\n"}); err != nil {
+		t.Errorf("checkstrs(%q) = %v, want: nil", "あい\n", err)
+	}
+}
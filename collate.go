@@ -0,0 +1,194 @@
+package gaga
+
+import (
+	"bytes"
+	"sort"
+	"unicode/utf8"
+)
+
+// The levels of the collation keys, which follow JIS X 4061.
+const (
+	clBase    = iota // the base letter
+	clVoicing        // unvoiced < voiced < semi-voiced
+	clSize           // prolonged sound mark < small < large
+	clScript         // Hiragana < Katakana, lower case < upper case
+	clWidth          // the width of the Fold < the other width
+	clNum
+)
+
+// collationNormalizer normalizes the text for CollationKey, keeping the
+// differences that the levels of the collation keys need.
+var collationNormalizer = func() *Normalizer {
+	n, err := Norm(ComposeVom | ExpandIterationMark)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}()
+
+// collationElement is a rune weighted at each level.
+type collationElement struct {
+	base    rune
+	weights [clNum - 1]byte
+}
+
+// kanaVowel returns the vowel of the Hiragana letter r, such as [あ] for
+// [か], or false if the r has no vowel.
+func kanaVowel(r rune) (rune, bool) {
+	ro, ok := romajiTable[string(r)]
+	if !ok {
+		return 0, false
+	}
+	switch ro.hepburn[len(ro.hepburn)-1] {
+	case 'a':
+		return 'あ', true
+	case 'i':
+		return 'い', true
+	case 'u':
+		return 'う', true
+	case 'e':
+		return 'え', true
+	case 'o':
+		return 'お', true
+	default:
+		return 0, false
+	}
+}
+
+// collationElementOf returns the collation element of the rune r, which
+// is followed by the voicing modifier m (or vmNone), after the element
+// prev.
+func collationElementOf(r rune, m vom, prev collationElement) collationElement {
+	e := collationElement{base: r, weights: [clNum - 1]byte{1, 1, 1, 1}}
+	c, ok := findUnichar(r)
+	if !ok {
+		return e
+	}
+	w := func(level int, weight byte) { e.weights[level-1] = weight }
+	// The width of the Fold, that is, the half-width Latin characters
+	// and the full-width kana, comes first.
+	fold := uint8(cwWide)
+	switch c.category {
+	case ctLatinLetter, ctLatinDigit, ctLatinSymbol:
+		fold = cwNarrow
+	}
+	if c.charWidth != fold && c.charWidth != cwUndefined {
+		w(clWidth, 2)
+	}
+	switch c.category {
+	case ctLatinLetter:
+		c = c.toNarrowC()
+		e.base = c.toLowerR()
+		if c.charCase == ccUpper {
+			w(clScript, 2)
+		}
+	case ctLatinDigit, ctLatinSymbol:
+		e.base = c.toNarrowR()
+	case ctKanaSymbol, ctKanaVom:
+		e.base = c.toWideR()
+	case ctKanaLetter:
+		if isProlongedSoundMark(r) {
+			// A prolonged sound mark is the vowel of the preceding letter.
+			e.base = 'ー'
+			if v, ok := kanaVowel(prev.base); ok {
+				e.base = v
+			}
+			w(clSize, 1)
+			return e
+		}
+		c = c.toWideC()
+		if m.isVsm() {
+			if r2, m2 := c.composeVoiced(); m2.isNone() {
+				c = findUnicharForSure(r2)
+			}
+		} else if m.isSsm() {
+			if r2, m2 := c.composeSemivoiced(); m2.isNone() {
+				c = findUnicharForSure(r2)
+			}
+		}
+		switch c.voicing {
+		case vcVoiced:
+			w(clVoicing, 2)
+		case vcSemivoiced:
+			w(clVoicing, 3)
+		}
+		if c.charCase == ccKatakana {
+			w(clScript, 2)
+		}
+		c = c.toUnvoicedC()
+		if large := c.toLargeC(); large != c {
+			c = large
+			w(clSize, 2)
+		} else {
+			w(clSize, 3)
+		}
+		e.base = c.toHiraganaC().codepoint
+	}
+	return e
+}
+
+// CollationKey returns the key of the s for sorting in the Japanese
+// collation order, which follows the levels of JIS X 4061: the base
+// letters, ignoring the voicing, the size, the script and the width of
+// kana and the case of Latin letters, are compared first, then the
+// voicing, the size, the script or the case, and the width.
+// Comparing the keys with bytes.Compare gives the order of the texts.
+func CollationKey(s string) []byte {
+	s = collationNormalizer.String(s)
+	elems := make([]collationElement, 0, len(s))
+	var prev collationElement
+	for p := 0; p < len(s); {
+		r, size := utf8.DecodeRuneInString(s[p:])
+		p += size
+		m := vmNone
+		if c, ok := findUnichar(r); ok && c.category == ctKanaLetter && p < len(s) {
+			if r2, size2 := utf8.DecodeRuneInString(s[p:]); vom(r2).isVom() {
+				m = vom(r2)
+				p += size2
+			}
+		}
+		prev = collationElementOf(r, m, prev)
+		elems = append(elems, prev)
+	}
+
+	// The base letters are encoded in 3 bytes each, which are greater
+	// than the separator of the levels.
+	key := make([]byte, 0, len(elems)*(3+clNum-1)+clNum+len(s))
+	for _, e := range elems {
+		r := e.base + 1
+		key = append(key, byte(r>>16), byte(r>>8), byte(r))
+	}
+	key = append(key, 0, 0, 0)
+	for level := 0; level < clNum-1; level++ {
+		for _, e := range elems {
+			key = append(key, e.weights[level])
+		}
+		key = append(key, 0)
+	}
+	// The text itself breaks the ties.
+	return append(key, s...)
+}
+
+// Collator compares the texts in the Japanese collation order of
+// CollationKey.
+type Collator struct{}
+
+// Compare returns an integer comparing the a and the b in the Japanese
+// collation order. The result will be 0 if a == b, -1 if a < b, and +1
+// if a > b.
+func (c *Collator) Compare(a, b string) int {
+	return bytes.Compare(CollationKey(a), CollationKey(b))
+}
+
+// Sort sorts the ss in the Japanese collation order.
+func (c *Collator) Sort(ss []string) {
+	keys := make(map[string][]byte, len(ss))
+	for _, s := range ss {
+		if _, ok := keys[s]; !ok {
+			keys[s] = CollationKey(s)
+		}
+	}
+	sort.SliceStable(ss, func(i, j int) bool {
+		return bytes.Compare(keys[ss[i]], keys[ss[j]]) < 0
+	})
+}
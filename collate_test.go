@@ -0,0 +1,65 @@
+package gaga
+
+import (
+	"reflect"
+	"testing"
+)
+
+type CollatorTest struct {
+	a, b string
+	want int
+}
+
+var collatortests = []CollatorTest{
+	0:  {"", "", 0},
+	1:  {"", "あ", -1},
+	2:  {"か", "が", -1},
+	3:  {"が", "き", -1},
+	4:  {"カ", "が", -1},
+	5:  {"ｶ", "が", -1},
+	6:  {"は", "ば", -1},
+	7:  {"ば", "ぱ", -1},
+	8:  {"っ", "つ", -1},
+	9:  {"ツ", "っ", 1},
+	10: {"あ", "ア", -1},
+	11: {"ア", "ｱ", -1},
+	12: {"ｶﾞ", "ガ", 1},
+	13: {"ｶﾞ", "か", 1},
+	14: {"ｶﾞ", "き", -1},
+	15: {"か゛", "が", 0},
+	16: {"カー", "かあ", -1},
+	17: {"かあ", "かい", -1},
+	18: {"カード", "カアド", -1},
+	19: {"いすゞ", "いすず", 0},
+	20: {"a", "B", -1},
+	21: {"a", "A", -1},
+	22: {"Ａ", "A", 1},
+	23: {"abc", "ab", 1},
+	24: {"1", "a", -1},
+	25: {"z", "あ", -1},
+	26: {"ん", "亜", -1},
+	27: {"ヴ", "う", 1},
+	28: {"ヴ", "え", -1},
+}
+
+func TestCollator_Compare(t *testing.T) {
+	var c Collator
+	for i, tt := range collatortests {
+		if have := c.Compare(tt.a, tt.b); have != tt.want {
+			t.Errorf("#%d Compare(%q, %q) = %d, want: %d", i, tt.a, tt.b, have, tt.want)
+		}
+		if have := c.Compare(tt.b, tt.a); have != -tt.want {
+			t.Errorf("#%d Compare(%q, %q) = %d, want: %d", i, tt.b, tt.a, have, -tt.want)
+		}
+	}
+}
+
+func TestCollator_Sort(t *testing.T) {
+	var c Collator
+	ss := []string{"ｶﾞｲﾄﾞ", "カード", "かいと", "がいど", "カイト", "カアド", "きっと", "キット"}
+	want := []string{"カード", "カアド", "かいと", "カイト", "がいど", "ｶﾞｲﾄﾞ", "きっと", "キット"}
+	c.Sort(ss)
+	if !reflect.DeepEqual(ss, want) {
+		t.Errorf("Sort\n\thave: %q\n\twant: %q", ss, want)
+	}
+}
@@ -0,0 +1,73 @@
+package gaga
+
+import (
+	"unicode/utf8"
+)
+
+// normCtx is the context that the runes preceding the rune being
+// normalized make, on which the context-dependent flags depend.
+type normCtx struct {
+	class ctxClass // for the ProlongedSoundMarkByContext
+	last  rune     // the rune that an iteration mark repeats, whose width a dash follows
+}
+
+// contextFlags are the flags that depend on the context.
+const contextFlags = ProlongedSoundMarkByContext |
+	ExpandIterationMark | CollapseIterationMark
+
+// normRune is like normalizeRune, but also applies the custom rules, and
+// returns the normalized text encoded into the buf or the replacement of
+// a rule.
+func (n *Normalizer) normRune(buf []byte, r rune) []byte {
+	if out, ok := n.ruleOut[r]; ok {
+		return out
+	}
+	e := n.lookup(r)
+	return buf[:encodeNorm(buf, e.r, e.m)]
+}
+
+// nextInContext is like nextRule, but also applies the context-dependent
+// flags, such as the ProlongedSoundMarkByContext and the iteration mark
+// flags.
+func (n *Normalizer) nextInContext(buf []byte, in *input, p int, atEOF bool) ([]byte, int) {
+	if p == in.nextPos {
+		in.ctx = in.nextCtx
+	}
+	if !atEOF && !in.fullRune(p) {
+		return nil, 0
+	}
+	r, size := in.decodeRune(p)
+	_, rule := n.ruleOut[r]
+	if !rule && n.flag.has(ProlongedSoundMarkByContext) && isDashLike(r) {
+		to, short := dashByContext(in, p, r, size, in.ctx, atEOF)
+		if short {
+			return nil, 0
+		}
+		if to != 0 {
+			e := n.lookup(to)
+			in.nextPos, in.nextCtx = p+size, normCtx{runeClass(to), to}
+			return buf[:encodeNorm(buf, e.r, e.m)], size
+		}
+	}
+	if !rule && n.flag.has(ExpandIterationMark) && isIterationMark(r) {
+		if to, ok := iterate(r, in.ctx.last); ok {
+			// The mark that follows repeats the same rune.
+			in.nextPos, in.nextCtx = p+size, normCtx{runeClass(to), in.ctx.last}
+			return n.normRune(buf, to), size
+		}
+	}
+	out, size := n.nextRule(buf, in, p, atEOF)
+	if size == 0 {
+		return nil, 0
+	}
+	if !rule && n.flag.has(CollapseIterationMark) {
+		if cur, l := utf8.DecodeRune(out); l == len(out) {
+			prev := n.lookup(in.ctx.last)
+			if mark, ok := iterationMark(prev.r, cur); ok && prev.m.isNone() {
+				out = n.normRune(buf, mark)
+			}
+		}
+	}
+	in.nextPos, in.nextCtx = p+size, normCtx{runeClass(r), r}
+	return out, size
+}
@@ -0,0 +1,52 @@
+package gaga
+
+// cp932ToJISList maps the characters of Microsoft's mapping of Shift_JIS
+// (CP932) to those of the JIS mapping, which differ for the same codes.
+var cp932ToJISList = map[rune]rune{
+	'～': '〜', // 0x8160 U+FF5E FULLWIDTH TILDE -> U+301C WAVE DASH
+	'∥': '‖', // 0x8161 U+2225 PARALLEL TO -> U+2016 DOUBLE VERTICAL LINE
+	'－': '−', // 0x817C U+FF0D FULLWIDTH HYPHEN-MINUS -> U+2212 MINUS SIGN
+	'￠': '¢', // 0x8191 U+FFE0 FULLWIDTH CENT SIGN -> U+00A2 CENT SIGN
+	'￡': '£', // 0x8192 U+FFE1 FULLWIDTH POUND SIGN -> U+00A3 POUND SIGN
+	'￢': '¬', // 0x81CA U+FFE2 FULLWIDTH NOT SIGN -> U+00AC NOT SIGN
+}
+
+// jisToCP932List is the reverse of the cp932ToJISList.
+var jisToCP932List = func() map[rune]rune {
+	m := make(map[rune]rune, len(cp932ToJISList))
+	for k, v := range cp932ToJISList {
+		m[v] = k
+	}
+	return m
+}()
+
+// mappingVariant returns the r converted according to the CP932ToJIS
+// or JISToCP932 of the f, and whether the r is a character that the
+// two mappings differ in. Such characters are not converted by the
+// width flags.
+func (f NormFlag) mappingVariant(r rune) (rune, bool) {
+	if to, ok := cp932ToJISList[r]; ok {
+		if f.has(CP932ToJIS) {
+			return to, true
+		}
+		return r, true
+	}
+	if to, ok := jisToCP932List[r]; ok {
+		if f.has(JISToCP932) {
+			return to, true
+		}
+		return r, true
+	}
+	return r, false
+}
+
+// unifyMapping converts the r, which the width flags have given, to the
+// variant of the mapping specified by the f. The [－] widened from [-] is
+// left as it is, because the hyphen-minus is not a minus sign.
+func (f NormFlag) unifyMapping(r rune) rune {
+	if !f.has(CP932ToJIS|JISToCP932) || r == '－' {
+		return r
+	}
+	r2, _ := f.mappingVariant(r)
+	return r2
+}
@@ -0,0 +1,101 @@
+package gaga
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Change is a change that the Normalizer made to the source text.
+type Change struct {
+	Offset int      // byte offset of the changed runes in the source text
+	Src    []rune   // the original runes
+	Out    []rune   // the replacement runes
+	Flag   NormFlag // the normalization flags responsible for the change
+	Rule   bool     // the change was made by a custom rule
+}
+
+func formatRunes(rs []rune) string {
+	if len(rs) == 0 {
+		return "<empty>"
+	}
+	ss := make([]string, len(rs))
+	for i, r := range rs {
+		ss[i] = fmt.Sprintf("%U", r)
+	}
+	return strings.Join(ss, " ")
+}
+
+// String returns the change in the form of
+// "U+FF76 U+FF9E -> U+30AC (KatakanaToWide|ComposeVom)".
+// A change made by a custom rule is followed by "(rule)".
+func (c Change) String() string {
+	s := formatRunes(c.Src) + " -> " + formatRunes(c.Out)
+	if c.Rule {
+		s += " (rule)"
+	} else if names := c.Flag.names(); len(names) > 0 {
+		s += " (" + strings.Join(names, "|") + ")"
+	}
+	return s
+}
+
+// responsible returns the flags of n without which the normalization
+// of the rune at in[p:] would give a result other than the out. If no
+// such flag exists because some flags have the same effect, it returns
+// a minimal subset of the flags of n that still gives the out.
+// The in must be the one that the rune has just been normalized with,
+// so that the rune is normalized in the same context.
+func (n *Normalizer) responsible(in *input, p int, out []byte) NormFlag {
+	var buf [utf8.UTFMax * 2]byte
+	gives := func(flag NormFlag) bool {
+		without := uncompiled(flag)
+		in2 := *in
+		norm, _ := without.next(buf[:], &in2, p, true)
+		return string(norm) == string(out)
+	}
+	var flag NormFlag
+	for f := NormFlag(1); f < normflagMax; f <<= 1 {
+		if n.flag.has(f) && !gives(n.flag&^f) {
+			flag |= f
+		}
+	}
+	if flag != 0 {
+		return flag
+	}
+	flag = n.flag
+	for f := NormFlag(1); f < normflagMax; f <<= 1 {
+		if flag.has(f) && gives(flag&^f) {
+			flag &^= f
+		}
+	}
+	return flag
+}
+
+// Explain normalizes the s according to the current normalization mode
+// and returns every change made, in the order of the source text.
+// A base letter and the voicing modifier that follows it are reported
+// as a single change.
+func (n *Normalizer) Explain(s string) []Change {
+	var buf [utf8.UTFMax * 2]byte
+	var changes []Change
+	in := inputString(s)
+	for p := 0; p < len(s); {
+		norm, size := n.next(buf[:], &in, p, true)
+		if len(norm) != size || !in.equal(p, norm) {
+			src, out := s[p:p+size], string(norm)
+			_, rule := n.rules[[]rune(src)[0]]
+			c := Change{
+				Offset: p,
+				Src:    []rune(src),
+				Out:    []rune(out),
+				Rule:   rule,
+			}
+			if !rule {
+				c.Flag = n.responsible(&in, p, norm)
+			}
+			changes = append(changes, c)
+		}
+		p += size
+	}
+	return changes
+}
@@ -0,0 +1,95 @@
+package gaga
+
+import (
+	"fmt"
+	"testing"
+)
+
+type Normalizer_ExplainTest struct {
+	flag NormFlag
+	in   string
+	out  []string
+}
+
+var normalizer_explaintests = []Normalizer_ExplainTest{
+	0: {Fold, "", nil},
+	1: {Fold, "abcアイウ", nil},
+	2: {Fold, "ｶﾞ", []string{
+		"0: U+FF76 U+FF9E -> U+30AC (KatakanaToWide|ComposeVom)"}},
+	3: {Fold, "Ａｱ", []string{
+		"0: U+FF21 -> U+0041 (AlphaToNarrow)",
+		"3: U+FF71 -> U+30A2 (KatakanaToWide)"}},
+	4: {LatinToNarrow | AlphaToUpper, "ａb", []string{
+		"0: U+FF41 -> U+0041 (AlphaToNarrow|AlphaToUpper)",
+		"3: U+0062 -> U+0042 (AlphaToUpper)"}},
+	5: {KanaToHiragana, "か゛ヴ", []string{
+		"0: U+304B U+309B -> U+304C (ComposeVom)",
+		"6: U+30F4 -> U+3094 (KatakanaToHiragana)"}},
+	6: {DecomposeVom, "が", []string{
+		"0: U+304C -> U+304B U+3099 (DecomposeVom)"}},
+	7: {Fold, "\xff", []string{
+		"0: U+FFFD -> U+FFFD"}},
+	8: {Fold | ProlongedSoundMarkByContext, "ｶ－03－1", []string{
+		"0: U+FF76 -> U+30AB (KatakanaToWide)",
+		"3: U+FF0D -> U+30FC (KatakanaToWide|ProlongedSoundMarkByContext)",
+		"8: U+FF0D -> U+002D (SymbolToNarrow)"}},
+	9: {Fold | ProlongedSoundMarkByContext, "03ー1", []string{
+		"2: U+30FC -> U+002D (ProlongedSoundMarkByContext)"}},
+	10: {Fold | StripVoicing, "ｶﾞ゛", []string{
+		"0: U+FF76 U+FF9E -> U+30AB (KatakanaToWide|StripVoicing)",
+		"6: U+309B -> <empty> (StripVoicing)"}},
+	11: {Fold | ExpandIterationMark, "ｶヾ時々", []string{
+		"0: U+FF76 -> U+30AB (KatakanaToWide)",
+		"3: U+30FE -> U+30AC (ExpandIterationMark)",
+		"9: U+3005 -> U+6642 (ExpandIterationMark)"}},
+	12: {KanaToHiragana | ModernizeKana, "ヷゐ", []string{
+		"0: U+30F7 -> U+3094 U+3041 (KatakanaToHiragana|ModernizeKana)",
+		"3: U+3090 -> U+3044 (ModernizeKana)"}},
+}
+
+func TestNormalizer_Explain(t *testing.T) {
+	for i, tt := range normalizer_explaintests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		changes := n.Explain(tt.in)
+		if len(changes) != len(tt.out) {
+			t.Errorf("#%d %s, Explain(%q) = %v, want: %v", i, tt.flag, tt.in, changes, tt.out)
+			continue
+		}
+		for j, c := range changes {
+			have := fmt.Sprintf("%d: %s", c.Offset, c)
+			if have != tt.out[j] {
+				t.Errorf("#%d %s, Explain(%q)[%d]\n\thave: %s\n\twant: %s",
+					i, tt.flag, tt.in, j, have, tt.out[j])
+			}
+		}
+	}
+}
+
+func TestNormalizer_ExplainString(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		// Applying the changes to the source must give the normalized string.
+		var out []rune
+		p := 0
+		for _, c := range n.Explain(tt.in) {
+			out = append(out, []rune(tt.in[p:c.Offset])...)
+			out = append(out, c.Out...)
+			p = c.Offset + len(string(c.Src))
+			if c.Flag == 0 {
+				t.Errorf("#%d %s, Explain(%q), %s has no responsible flag", i, tt.flag, tt.in, c)
+			}
+		}
+		out = append(out, []rune(tt.in[p:])...)
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, Explain(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, string(out), tt.out)
+		}
+	}
+}
@@ -136,3 +136,34 @@ func ExampleNormalizer_String() {
 	// 4 ＧａGa is not ｶﾞｶﾞｶﾞ
 	// 5 GAGA IS NOT ガガガ
 }
+
+func ExampleRomanize() {
+	s := "とうきょう ｼﾝﾌﾞﾝｼ"
+	for _, system := range []gaga.RomajiSystem{
+		gaga.Hepburn,
+		gaga.Hepburn | gaga.LongVowelMacron,
+		gaga.Kunrei | gaga.LongVowelCircumflex,
+		gaga.Passport | gaga.LongVowelOH,
+	} {
+		ro, _ := gaga.Romanize(s, system)
+		fmt.Println(ro)
+	}
+	// Output:
+	// toukyou shinbunshi
+	// tōkyō shinbunshi
+	// tôkyô sinbunsi
+	// TOHKYOH SHIMBUNSHI
+}
+
+func ExampleCountMorae() {
+	// The reading of "閑さや岩にしみ入る蝉の声"
+	for _, s := range []string{"しずかさや", "いわにしみいる", "せみのこえ"} {
+		fmt.Println(gaga.CountMorae(s), gaga.Morae(s))
+	}
+	fmt.Println(gaga.CountMorae("キャットフード"), gaga.Morae("キャットフード"))
+	// Output:
+	// 5 [し ず か さ や]
+	// 7 [い わ に し み い る]
+	// 5 [せ み の こ え]
+	// 6 [きゃ っ と ふ ー ど]
+}
@@ -5,12 +5,18 @@ gen implements gaga's auto-generator.
 
 Usage:
 	go run generate.go -output path
+	go run genkanjitables.go -output path
+	go run gencharsettables.go -output path
 	go run genucdcsv.go -output path
 	go run genucdexcsv.go -output path
 
 The coommands are:
 	generate.go
 		print the unichar_tables (go source code).
+	genkanjitables.go
+		print the kanji_tables (go source code).
+	gencharsettables.go
+		print the charset_tables (go source code).
 	genucdcsv.go
 		print the UCD in csv.
 	genucdexcsv.go
@@ -18,6 +24,8 @@ The coommands are:
 
 Examples:
 	go run generate.go -output unichar_tables.go
+	go run genkanjitables.go -output kanji_tables.go
+	go run gencharsettables.go -output charset_tables.go
 	go run genucdcsv.go -output ucd.csv
 	go run genucdexcsv.go -output ucdex.csv
 
@@ -0,0 +1,302 @@
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"golang.org/x/text/encoding/japanese"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const jisx0213URL = "https://x0213.org/codetable/jisx0213-2004-std.txt"
+
+// jisx0213SHA256 is the SHA-256 of the file of the jisx0213URL, which is
+// verified before the file is parsed. It must be pinned by hand after the
+// file is reviewed, since verifyJISX0213 fails while it is empty and
+// reports the SHA-256 of the file downloaded.
+const jisx0213SHA256 = ""
+
+// The pairs of the characters that CP932 and JIS map differently, which
+// must be the same as the cp932ToJISList of cp932.go.
+var cp932JISPairs = []struct {
+	cp932 rune
+	jis   rune
+}{
+	{'～', '〜'}, // U+FF5E, U+301C
+	{'∥', '‖'}, // U+2225, U+2016
+	{'－', '−'}, // U+FF0D, U+2212
+	{'￠', '¢'}, // U+FFE0, U+00A2
+	{'￡', '£'}, // U+FFE1, U+00A3
+	{'￢', '¬'}, // U+FFE2, U+00AC
+}
+
+// The characters of the Zengin (the Japanese Bankers Association) format
+// for the bank transfers, which are in half-width.
+const zenginChars = "0123456789" +
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
+	"ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝﾞﾟ" +
+	" ()-./\\,｢｣"
+
+// charset is a set of characters, which is generated as a
+// unicode.RangeTable.
+type charset struct {
+	name    string
+	comment string
+	runes   map[rune]bool
+}
+
+func newCharset(name, comment string) *charset {
+	return &charset{name, comment, make(map[rune]bool)}
+}
+
+func (cs *charset) addASCII() {
+	for r := rune(0x20); r <= 0x7E; r++ {
+		cs.runes[r] = true
+	}
+}
+
+// jisRow returns the row of JIS X 0208 (1-120) of the double-byte
+// character of Shift_JIS.
+func jisRow(lead, trail byte) int {
+	var row int
+	if lead < 0xA0 {
+		row = int(lead-0x81)*2 + 1
+	} else {
+		row = int(lead-0xC1)*2 + 1
+	}
+	if trail >= 0x9F {
+		row++
+	}
+	return row
+}
+
+// createCP932Charsets returns the charsets of JIS X 0208 and CP932,
+// which are decoded from all the double-byte characters of Shift_JIS
+// with golang.org/x/text/encoding/japanese, which is CP932 in fact.
+// JIS X 0208 consists of the rows 1-8 and 16-84 of them, and the
+// characters that CP932 and JIS map differently are replaced.
+func createCP932Charsets() (jisx0208, cp932 *charset, err error) {
+	jisx0208 = newCharset("jisx0208Table", "The characters of JIS X 0208 and ASCII")
+	cp932 = newCharset("cp932Table", "The characters of CP932 (Windows-31J), except for the user-defined ones")
+	jisx0208.addASCII()
+	cp932.addASCII()
+	for r := rune(0xFF61); r <= 0xFF9F; r++ {
+		cp932.runes[r] = true
+	}
+	dec := japanese.ShiftJIS.NewDecoder()
+	for lead := 0x81; lead <= 0xFC; lead++ {
+		if 0xA0 <= lead && lead <= 0xDF {
+			continue
+		}
+		for trail := 0x40; trail <= 0xFC; trail++ {
+			if trail == 0x7F {
+				continue
+			}
+			s, err := dec.Bytes([]byte{byte(lead), byte(trail)})
+			if err != nil {
+				return nil, nil, err
+			}
+			r, size := utf8.DecodeRune(s)
+			if size != len(s) || r == utf8.RuneError || unicode.Is(unicode.Co, r) {
+				continue
+			}
+			cp932.runes[r] = true
+			if row := jisRow(byte(lead), byte(trail)); row <= 8 || (16 <= row && row <= 84) {
+				jisx0208.runes[r] = true
+			}
+		}
+	}
+	for _, p := range cp932JISPairs {
+		if !jisx0208.runes[p.cp932] || !cp932.runes[p.cp932] || cp932.runes[p.jis] {
+			return nil, nil, fmt.Errorf("unexpected mapping of %#U and %#U", p.cp932, p.jis)
+		}
+		delete(jisx0208.runes, p.cp932)
+		jisx0208.runes[p.jis] = true
+	}
+	return jisx0208, cp932, nil
+}
+
+// parseJISX0213 parses the mapping table of JIS X 0213 in the format of
+// "3-2477	U+304B+309A	# ...", and returns the runes of the characters.
+// The runes of a character that is mapped to a sequence of code points
+// are returned separately.
+func parseJISX0213(r io.Reader) ([]rune, error) {
+	var runes []rune
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || !strings.HasPrefix(fields[1], "U+") {
+			continue
+		}
+		for _, u := range strings.Split(fields[1][2:], "+") {
+			cp, err := strconv.ParseUint(u, 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", line, err)
+			}
+			runes = append(runes, rune(cp))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return runes, nil
+}
+
+// verifyJISX0213 returns an error if the SHA-256 of the b is not the
+// want.
+func verifyJISX0213(b []byte, want string) error {
+	sum := sha256.Sum256(b)
+	if have := hex.EncodeToString(sum[:]); have != want {
+		return fmt.Errorf("%s: SHA-256 mismatch: have %s, want %q", jisx0213URL, have, want)
+	}
+	return nil
+}
+
+func readJISX0213() ([]rune, error) {
+	resp, err := http.Get(jisx0213URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", jisx0213URL, resp.Status)
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJISX0213(b, jisx0213SHA256); err != nil {
+		return nil, err
+	}
+	return parseJISX0213(bytes.NewReader(b))
+}
+
+// createCharsets returns the charsets, which JIS X 0213 is created from
+// JIS X 0208 and the runes of the mapping table of JIS X 0213.
+func createCharsets(jisx0213Runes []rune) ([]*charset, error) {
+	jisx0208, cp932, err := createCP932Charsets()
+	if err != nil {
+		return nil, err
+	}
+	jisx0213 := newCharset("jisx0213Table", "The characters of JIS X 0213 and ASCII")
+	for r := range jisx0208.runes {
+		jisx0213.runes[r] = true
+	}
+	for _, r := range jisx0213Runes {
+		jisx0213.runes[r] = true
+	}
+	zengin := newCharset("zenginTable", "The characters of the Zengin format")
+	for _, r := range zenginChars {
+		zengin.runes[r] = true
+	}
+	return []*charset{jisx0208, jisx0213, cp932, zengin}, nil
+}
+
+// rangeTable returns the ranges of the sorted runes, in which the runes
+// of a range are at regular intervals.
+func rangeTable(runes []rune) (r16 []unicode.Range16, r32 []unicode.Range32) {
+	is16 := func(r rune) bool { return r <= 0xFFFF }
+	for i := 0; i < len(runes); {
+		lo, stride := runes[i], rune(1)
+		j := i + 1
+		if j < len(runes) && is16(runes[j]) == is16(lo) {
+			stride = runes[j] - lo
+			for j < len(runes) && runes[j]-runes[j-1] == stride && is16(runes[j]) == is16(lo) {
+				j++
+			}
+		}
+		// Two runes at a long interval are not a range.
+		if j-i == 2 && stride > 1 {
+			j, stride = i+1, 1
+		}
+		hi := runes[j-1]
+		if is16(lo) {
+			r16 = append(r16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: uint16(stride)})
+		} else {
+			r32 = append(r32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: uint32(stride)})
+		}
+		i = j
+	}
+	return
+}
+
+func generateCharsets(f io.Writer, charsets []*charset, genname string) {
+	fmt.Fprintf(f, "// Code generated by %s; DO NOT EDIT.\n", genname)
+	fmt.Fprint(f, "// Based on information from golang.org/x/text/encoding/japanese,\n")
+	fmt.Fprintf(f, "// %s (SHA-256 %s)\n", jisx0213URL, jisx0213SHA256)
+	fmt.Fprint(f, "// and the zenginChars of gen/lib/charset.go\n\n")
+	fmt.Fprint(f, "package gaga\n\n")
+	fmt.Fprint(f, "import \"unicode\"\n")
+
+	for _, cs := range charsets {
+		runes := make([]rune, 0, len(cs.runes))
+		for r := range cs.runes {
+			runes = append(runes, r)
+		}
+		sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+		r16, r32 := rangeTable(runes)
+
+		fmt.Fprintf(f, "\n// %s (%d characters)\n", cs.comment, len(runes))
+		fmt.Fprintf(f, "var %s = &unicode.RangeTable{\n", cs.name)
+		fmt.Fprint(f, "\tR16: []unicode.Range16{\n")
+		latinOffset := 0
+		for _, r := range r16 {
+			fmt.Fprintf(f, "\t\t{0x%04X, 0x%04X, %d},\n", r.Lo, r.Hi, r.Stride)
+			if r.Hi <= unicode.MaxLatin1 {
+				latinOffset++
+			}
+		}
+		fmt.Fprint(f, "\t},\n")
+		if len(r32) > 0 {
+			fmt.Fprint(f, "\tR32: []unicode.Range32{\n")
+			for _, r := range r32 {
+				fmt.Fprintf(f, "\t\t{0x%X, 0x%X, %d},\n", r.Lo, r.Hi, r.Stride)
+			}
+			fmt.Fprint(f, "\t},\n")
+		}
+		if latinOffset > 0 {
+			fmt.Fprintf(f, "\tLatinOffset: %d,\n", latinOffset)
+		}
+		fmt.Fprint(f, "}\n")
+	}
+}
+
+// GenerateCharsets generates the character sets (Go source code)
+func GenerateCharsets(f io.Writer, genname string) error {
+	jisx0213Runes, err := readJISX0213()
+	if err != nil {
+		return err
+	}
+
+	charsets, err := createCharsets(jisx0213Runes)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	generateCharsets(&buf, charsets, genname)
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(out)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package lib
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+type RangeTableTest struct {
+	in  []rune
+	r16 []unicode.Range16
+	r32 []unicode.Range32
+}
+
+var rangetabletests = []RangeTableTest{
+	0: {nil, nil, nil},
+	1: {[]rune{0x41}, []unicode.Range16{{0x41, 0x41, 1}}, nil},
+	2: {[]rune{0x41, 0x42, 0x43}, []unicode.Range16{{0x41, 0x43, 1}}, nil},
+	3: {[]rune{0x41, 0x43, 0x45, 0x46}, []unicode.Range16{{0x41, 0x45, 2}, {0x46, 0x46, 1}}, nil},
+	4: {[]rune{0x41, 0x50, 0x51}, []unicode.Range16{{0x41, 0x41, 1}, {0x50, 0x51, 1}}, nil},
+	5: {[]rune{0xFFFE, 0xFFFF, 0x10000, 0x10001},
+		[]unicode.Range16{{0xFFFE, 0xFFFF, 1}}, []unicode.Range32{{0x10000, 0x10001, 1}}},
+}
+
+func TestRangeTable(t *testing.T) {
+	for i, tt := range rangetabletests {
+		r16, r32 := rangeTable(tt.in)
+		if !reflect.DeepEqual(r16, tt.r16) || !reflect.DeepEqual(r32, tt.r32) {
+			t.Errorf("#%d rangeTable(%X) = %v, %v, want: %v, %v", i, tt.in, r16, r32, tt.r16, tt.r32)
+		}
+	}
+}
+
+func TestParseJISX0213(t *testing.T) {
+	in := "## comment\n" +
+		"3-2121\tU+3000\t# IDEOGRAPHIC SPACE\n" +
+		"3-2477\tU+304B+309A\t# [2000]\n" +
+		"3-2F7E\t\t# <reserved>\n" +
+		"4-2121\tU+20089\t# <cjk>\n"
+	runes, err := parseJISX0213(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("error: %s", err.Error())
+	}
+	if want := []rune{0x3000, 0x304B, 0x309A, 0x20089}; !reflect.DeepEqual(runes, want) {
+		t.Errorf("parseJISX0213() = %X, want: %X", runes, want)
+	}
+}
+
+func TestVerifyJISX0213(t *testing.T) {
+	b := []byte("abc")
+	// The SHA-256 of "abc" in FIPS 180-2.
+	sum := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if err := verifyJISX0213(b, sum); err != nil {
+		t.Errorf("verifyJISX0213(%q, %s): %s", b, sum, err.Error())
+	}
+	for _, want := range []string{"", strings.Repeat("0", 64)} {
+		if err := verifyJISX0213(b, want); err == nil {
+			t.Errorf("verifyJISX0213(%q, %q) = nil, want: error", b, want)
+		}
+	}
+}
+
+func TestCreateCharsets(t *testing.T) {
+	charsets, err := createCharsets([]rune{0x20089})
+	if err != nil {
+		t.Fatalf("error: %s", err.Error())
+	}
+	m := make(map[string]*charset)
+	for _, cs := range charsets {
+		m[cs.name] = cs
+	}
+	jisx0208, jisx0213, cp932, zengin := m["jisx0208Table"], m["jisx0213Table"], m["cp932Table"], m["zenginTable"]
+	// JIS X 0208 has 6879 characters.
+	if have, want := len(jisx0208.runes), 6879+95; have != want {
+		t.Errorf("len(jisx0208) = %d, want: %d", have, want)
+	}
+	if have, want := len(jisx0213.runes), len(jisx0208.runes)+1; have != want {
+		t.Errorf("len(jisx0213) = %d, want: %d", have, want)
+	}
+	for _, p := range cp932JISPairs {
+		if jisx0208.runes[p.cp932] || !jisx0208.runes[p.jis] || !cp932.runes[p.cp932] || cp932.runes[p.jis] {
+			t.Errorf("%#U and %#U are not replaced", p.cp932, p.jis)
+		}
+	}
+	for _, r := range "①髙ｱ" {
+		if jisx0208.runes[r] || !cp932.runes[r] {
+			t.Errorf("%#U, want: only in cp932", r)
+		}
+	}
+	if len(zengin.runes) != len([]rune(zenginChars)) {
+		t.Errorf("len(zengin) = %d, want: %d", len(zengin.runes), len([]rune(zenginChars)))
+	}
+}
+
+func TestHeavyGenerateCharsets(t *testing.T) {
+	var buf bytes.Buffer
+	err := GenerateCharsets(&buf, "charset_test.go")
+	//If it ends normally, the test is OK
+	if err != nil {
+		t.Errorf("error: %s", err.Error())
+	}
+}
@@ -7,5 +7,7 @@ Features:
 	generate the UCDEX data based on the UCD.
 	generate the UCDEX in csv.
 	generate the unichar_tables (go source code) based on the UCDEX.
+	generate the kanji_tables (go source code) based on the UCD and the itaiji list.
+	generate the charset_tables (go source code) based on the mapping tables of JIS and CP932.
 */
 package lib
@@ -0,0 +1,168 @@
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+)
+
+// CJK Compatibility Ideographs F900-FAFF
+var cjkCompatIdeographsBlock = blockRange{0xF900, 0xFAFF, "cjkCompatIdeographs"}
+
+// The variant kanji (itaiji) commonly used in personal and place names,
+// and their standard kanji. The list is reviewed by hand, so that only
+// the variants that are treated as the same kanji in names are folded.
+type itaiji struct {
+	variant  rune
+	standard rune
+}
+
+var itaijiList = []itaiji{
+	{'來', '来'}, // U+4F86 -> U+6765
+	{'兒', '児'}, // U+5152 -> U+5150
+	{'冨', '富'}, // U+51A8 -> U+5BCC
+	{'圓', '円'}, // U+5713 -> U+5186
+	{'國', '国'}, // U+570B -> U+56FD
+	{'增', '増'}, // U+589E -> U+5897
+	{'壽', '寿'}, // U+58FD -> U+5BFF
+	{'學', '学'}, // U+5B78 -> U+5B66
+	{'實', '実'}, // U+5BE6 -> U+5B9F
+	{'峯', '峰'}, // U+5CEF -> U+5CF0
+	{'嵜', '崎'}, // U+5D5C -> U+5D0E
+	{'嶋', '島'}, // U+5D8B -> U+5CF6
+	{'嶌', '島'}, // U+5D8C -> U+5CF6
+	{'廣', '広'}, // U+5EE3 -> U+5E83
+	{'彌', '弥'}, // U+5F4C -> U+5F25
+	{'德', '徳'}, // U+5FB7 -> U+5FB3
+	{'惠', '恵'}, // U+60E0 -> U+6075
+	{'曾', '曽'}, // U+66FE -> U+66FD
+	{'會', '会'}, // U+6703 -> U+4F1A
+	{'桒', '桑'}, // U+6852 -> U+6851
+	{'條', '条'}, // U+689D -> U+6761
+	{'榮', '栄'}, // U+69AE -> U+6804
+	{'槇', '槙'}, // U+69C7 -> U+69D9
+	{'槗', '橋'}, // U+69D7 -> U+6A4B
+	{'櫻', '桜'}, // U+6AFB -> U+685C
+	{'淸', '清'}, // U+6DF8 -> U+6E05
+	{'淺', '浅'}, // U+6DFA -> U+6D45
+	{'澁', '渋'}, // U+6F81 -> U+6E0B
+	{'澤', '沢'}, // U+6FA4 -> U+6CA2
+	{'濱', '浜'}, // U+6FF1 -> U+6D5C
+	{'瀨', '瀬'}, // U+7028 -> U+702C
+	{'盡', '尽'}, // U+76E1 -> U+5C3D
+	{'眞', '真'}, // U+771E -> U+771F
+	{'舘', '館'}, // U+8218 -> U+9928
+	{'藏', '蔵'}, // U+85CF -> U+8535
+	{'讀', '読'}, // U+8B80 -> U+8AAD
+	{'邉', '辺'}, // U+9089 -> U+8FBA
+	{'邊', '辺'}, // U+908A -> U+8FBA
+	{'邨', '村'}, // U+90A8 -> U+6751
+	{'靜', '静'}, // U+975C -> U+9759
+	{'驒', '騨'}, // U+9A52 -> U+9A28
+	{'髙', '高'}, // U+9AD9 -> U+9AD8
+	{'髮', '髪'}, // U+9AEE -> U+9AEA
+	{'黑', '黒'}, // U+9ED1 -> U+9ED2
+	{'齊', '斉'}, // U+9F4A -> U+6589
+	{'齋', '斎'}, // U+9F4B -> U+658E
+	{'龜', '亀'}, // U+9F9C -> U+4E80
+	{'﨑', '崎'}, // U+FA11 -> U+5D0E (no canonical decomposition)
+	{'﨔', '欅'}, // U+FA14 -> U+6B05 (no canonical decomposition)
+	{'𠮷', '吉'}, // U+20BB7 -> U+5409
+}
+
+// createKanjiVariants returns the map of the variant kanji to their
+// standard kanji, which consists of the canonical decompositions of the
+// CJK compatibility ideographs and the itaijiList.
+func createKanjiVariants(ucd *UCD) (map[rune]rune, error) {
+	m := make(map[rune]rune)
+	b := cjkCompatIdeographsBlock
+	for _, char := range ucd.Chars {
+		if char.Dt != "can" {
+			continue
+		}
+		codepoint, err := singleRuneFromCp(char.Cp)
+		if err != nil || codepoint < b.first || codepoint > b.last {
+			continue
+		}
+		standard, err := singleRuneFromCp(char.Dm)
+		if err != nil {
+			return nil, fmt.Errorf("createKanjiVariants; %#U: %s", codepoint, err)
+		}
+		m[codepoint] = standard
+	}
+	for _, it := range itaijiList {
+		if it.variant == it.standard {
+			return nil, fmt.Errorf("createKanjiVariants; %#U is mapped to itself", it.variant)
+		}
+		if prev, ok := m[it.variant]; ok {
+			return nil, fmt.Errorf("createKanjiVariants; %#U is mapped to both %#U and %#U",
+				it.variant, prev, it.standard)
+		}
+		m[it.variant] = it.standard
+	}
+
+	// The standard kanji of a compatibility ideograph may be a variant
+	// in the itaijiList, such as U+F92D -> U+4F86 -> U+6765.
+	for variant, standard := range m {
+		for i := 0; ; i++ {
+			next, ok := m[standard]
+			if !ok {
+				break
+			}
+			if i >= len(m) {
+				return nil, fmt.Errorf("createKanjiVariants; %#U is cyclic", variant)
+			}
+			standard = next
+		}
+		m[variant] = standard
+	}
+	return m, nil
+}
+
+func generateKanji(f io.Writer, m map[rune]rune, genname string) {
+	fmt.Fprintf(f, "// Code generated by %s; DO NOT EDIT.\n", genname)
+	fmt.Fprintf(f, "// Based on information from %s\n", ucdURL)
+	fmt.Fprint(f, "// and the itaijiList of gen/lib/kanji.go\n\n")
+	fmt.Fprint(f, "package gaga\n\n")
+
+	variants := make([]rune, 0, len(m))
+	for r := range m {
+		variants = append(variants, r)
+	}
+	sort.Slice(variants, func(i, j int) bool { return variants[i] < variants[j] })
+
+	fmt.Fprintln(f, "// The variant kanji and their standard kanji")
+	fmt.Fprintln(f, "var kanjiVariantTable = map[rune]rune{")
+	for _, r := range variants {
+		fmt.Fprintf(f, "\t0x%04X: 0x%04X, // %s -> %s\n", r, m[r], string([]rune{r}), string([]rune{m[r]}))
+	}
+	fmt.Fprint(f, "}\n")
+}
+
+// GenerateKanji generates the map of the variant kanji (Go source code)
+func GenerateKanji(f io.Writer, genname string) error {
+	ucd, err := readUCD()
+	if err != nil {
+		return err
+	}
+
+	m, err := createKanjiVariants(ucd)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	generateKanji(&buf, m, genname)
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(out)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
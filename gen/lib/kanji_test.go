@@ -0,0 +1,38 @@
+package lib
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestItaijiList(t *testing.T) {
+	ucd := UCD{Chars: []Char{
+		{Cp: "F92D", Dt: "can", Dm: "4F86"}, // 來 -> 來
+		{Cp: "FA12", Dt: "can", Dm: "6674"}, // 晴 -> 晴
+		{Cp: "4F86"},
+	}}
+	m, err := createKanjiVariants(&ucd)
+	if err != nil {
+		t.Fatalf("error: %s", err.Error())
+	}
+	if len(m) != len(itaijiList)+2 {
+		t.Errorf("len(m) = %d, want %d", len(m), len(itaijiList)+2)
+	}
+	for variant, standard := range m {
+		if _, ok := m[standard]; ok {
+			t.Errorf("%#U -> %#U is a variant", variant, standard)
+		}
+	}
+	if m[0xF92D] != '来' || m[0xFA12] != 0x6674 {
+		t.Errorf("U+F92D -> %#U, U+FA12 -> %#U, want '来' and U+6674", m[0xF92D], m[0xFA12])
+	}
+}
+
+func TestHeavyGenerateKanji(t *testing.T) {
+	var buf bytes.Buffer
+	err := GenerateKanji(&buf, "kanji_test.go")
+	//If it ends normally, the test is OK
+	if err != nil {
+		t.Errorf("error: %s", err.Error())
+	}
+}
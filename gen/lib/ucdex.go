@@ -19,19 +19,35 @@ type blockRange struct {
 // Latin (excepted control characters)
 var basicLatinBlock = blockRange{0x0020, 0x007F, "latin"}
 
+// Letterlike Symbols 2100-214F
+var letterlikeBlock = blockRange{0x2100, 0x214F, "letterlike"}
+
+// Enclosed Alphanumerics 2460-24FF
+var enclosedBlock = blockRange{0x2460, 0x24FF, "enclosed"}
+
 // CJK symbols 3000-303F, Hiragana 3040-309F, Katakana 30A0-30FF
 var jpKanaBlock = blockRange{0x3000, 0x30FF, "kana"}
 
 // Katakana Phonetic Extensions 31F0-31FF
 var jpKanaExtBlock = blockRange{0x31F0, 0x31FF, "kanaExt"}
 
+// Enclosed CJK Letters and Months 3200-32FF
+var enclosedCJKBlock = blockRange{0x3200, 0x32FF, "enclosedCJK"}
+
+// CJK Compatibility 3300-33FF
+var cjkCompatBlock = blockRange{0x3300, 0x33FF, "cjkCompat"}
+
 // Full width latin letter, Half width kana (excepted FFA0-FFEF)
 var widthFormBlock = blockRange{0xFF00, 0xFF9F, "width"}
 
 var blockRanges = []blockRange{
 	basicLatinBlock,
+	letterlikeBlock,
+	enclosedBlock,
 	jpKanaBlock,
 	jpKanaExtBlock,
+	enclosedCJKBlock,
+	cjkCompatBlock,
 	widthFormBlock,
 }
 
@@ -59,6 +75,8 @@ const (
 	ctKanaLetter
 	ctKanaSymbol
 	ctKanaModifier
+	ctEnclosed
+	ctCompat
 	ctMax
 )
 
@@ -72,6 +90,8 @@ var categories = constValues{
 		"ctKanaLetter",
 		"ctKanaSymbol",
 		"ctKanaVom",
+		"ctEnclosed",
+		"ctCompat",
 		"ctMax",
 	},
 	descs: []string{
@@ -82,6 +102,8 @@ var categories = constValues{
 		"// a Hiragana or Katakana letter",
 		"// a Japanese symbol, voicing modifiers excluded",
 		"// a Japanese voicing modifier (a voiced or semi-voiced sound mark)",
+		"// an enclosed or parenthesized character that has a decomposition",
+		"// a squared word, unit or letterlike symbol that has a decomposition",
 		"",
 	},
 }
@@ -187,6 +209,8 @@ type charex struct {
 	compatWidth      rune   // A width compatible character (Narrow-Wide)
 	compatVoiced     rune   // A voiced sound compatible character (Unvoiced-Voiced)
 	compatSemivoiced rune   // A semi-voiced sound compatible character (Unvoiced-Semivoiced)
+	compatSize       rune   // A size compatible character (Small-Large)
+	decomposition    []rune // A compatibility decomposition (Enclosed-Plain, Compat-Plain)
 }
 
 type ucdex map[rune]*charex
@@ -352,6 +376,17 @@ func isTargetCp(codepoints string) (bool, error) {
 	return isTargetRune(rs[0]), nil
 }
 
+// hasCompatDecomp reports whether the char has a compatibility
+// decomposition, such as <circle> and <compat>.
+func hasCompatDecomp(char *Char) bool {
+	switch char.Dt {
+	case "", "none", "can":
+		return false
+	default:
+		return char.Dm != "" && char.Dm != "#"
+	}
+}
+
 func char2category(char *Char) (uint8, error) {
 	switch char.Blk {
 	case "ASCII":
@@ -402,6 +437,16 @@ func char2category(char *Char) (uint8, error) {
 	case "Katakana_Ext":
 		return ctKanaLetter, nil
 
+	case "Enclosed_Alphanum", "Enclosed_CJK":
+		if hasCompatDecomp(char) {
+			return ctEnclosed, nil
+		}
+
+	case "CJK_Compat", "Letterlike_Symbols":
+		if hasCompatDecomp(char) {
+			return ctCompat, nil
+		}
+
 	default:
 		return 0, fmt.Errorf("unexpected char.Blk: %q", char.Blk)
 	}
@@ -457,6 +502,9 @@ func char2charCase(char *Char) (uint8, error) {
 	case "Katakana_Ext":
 		return ccKatakana, nil
 
+	case "Enclosed_Alphanum", "Enclosed_CJK", "CJK_Compat", "Letterlike_Symbols":
+		return ccUndefined, nil
+
 	default:
 		return 0, fmt.Errorf("unexpected char.Blk: %q", char.Blk)
 	}
@@ -479,6 +527,10 @@ func char2charWidth(char *Char) (charWidth uint8, compatWidth rune, err error) {
 		default:
 			return 0, rune(0), fmt.Errorf("unexpected char.Dt: %q", char.Dt)
 		}
+	case "Enclosed_Alphanum", "Enclosed_CJK", "CJK_Compat", "Letterlike_Symbols":
+		// The enclosed and compatibility characters are not converted
+		// in width, but expanded to their decompositions.
+		return cwUndefined, rune(0), nil
 	default:
 		return 0, rune(0), fmt.Errorf("unexpected char.Blk: %q", char.Blk)
 	}
@@ -499,6 +551,11 @@ func char2charWidth(char *Char) (charWidth uint8, compatWidth rune, err error) {
 }
 
 func char2compatCase(char *Char) (r rune, err error) {
+	if char.Blk == "Letterlike_Symbols" {
+		// The letterlike symbols such as U+2102 'ℂ' have no case
+		// compatible characters in the target range.
+		return rune(0), nil
+	}
 	switch char.Gc {
 	case "Lu":
 		r, err = singleRuneFromCp(char.Slc)
@@ -543,6 +600,18 @@ func char2voicing(char *Char) (voicing uint8, compatVoiced, compatSemivoiced run
 	}
 }
 
+func char2decomposition(char *Char) ([]rune, error) {
+	switch char.Blk {
+	case "Enclosed_Alphanum", "Enclosed_CJK", "CJK_Compat", "Letterlike_Symbols":
+	default:
+		return nil, nil
+	}
+	if !hasCompatDecomp(char) {
+		return nil, nil
+	}
+	return multiRunesFromCp(char.Dm)
+}
+
 func updateKanaRelation(m ucdex) error {
 	for _, charmap := range hiragana2katakana {
 		for cpHiragana := charmap.lo; cpHiragana <= charmap.hi; cpHiragana++ {
@@ -871,6 +940,46 @@ func updateVoicingWidthRelation(c *charex, m ucdex) error {
 	return nil
 }
 
+func updateSizeRelation(m ucdex) error {
+	names := make(map[string]*charex, len(m))
+	for _, c := range m {
+		names[c.na] = c
+	}
+	for _, c := range m {
+		// Only small kana letters are targeted.
+		// e.g. U+30C3 'ッ' KATAKANA LETTER SMALL TU -> U+30C4 'ツ' KATAKANA LETTER TU
+		if c.category != ctKanaLetter || !strings.Contains(c.na, " SMALL ") {
+			continue
+		}
+
+		small := c
+		large, ok := names[strings.Replace(small.na, "SMALL ", "", 1)]
+		if !ok {
+			return fmt.Errorf("updateSizeRelation; the large letter of %#U is not exists in ucdex",
+				small.codepoint)
+		}
+		if large.category != small.category {
+			return fmt.Errorf("updateSizeRelation; %#U.category is %s, but large %#U.category is %s",
+				small.codepoint, categories.name(small.category),
+				large.codepoint, categories.name(large.category))
+		}
+		if large.charCase != small.charCase {
+			return fmt.Errorf("updateSizeRelation; %#U.charCase is %s, but large %#U.charCase is %s",
+				small.codepoint, charCases.name(small.charCase),
+				large.codepoint, charCases.name(large.charCase))
+		}
+		if large.charWidth != small.charWidth {
+			return fmt.Errorf("updateSizeRelation; %#U.charWidth is %s, but large %#U.charWidth is %s",
+				small.codepoint, charWidths.name(small.charWidth),
+				large.codepoint, charWidths.name(large.charWidth))
+		}
+
+		// The main theme of this function
+		small.compatSize = large.codepoint
+	}
+	return nil
+}
+
 func updateAdditionalRefList(m ucdex) error {
 	for _, ad := range additionalRefList {
 		c, ok := m[ad.codepoint]
@@ -956,6 +1065,10 @@ func createUCDEX(ucd *UCD) (ucdex, error) {
 		if err != nil {
 			return nil, err
 		}
+		decomposition, err := char2decomposition(&char)
+		if err != nil {
+			return nil, err
+		}
 
 		m[codepoint] = &charex{
 			codepoint:        codepoint,
@@ -971,6 +1084,7 @@ func createUCDEX(ucd *UCD) (ucdex, error) {
 			compatWidth:      compatWidth,
 			compatVoiced:     compatVoiced,
 			compatSemivoiced: compatSemivoiced,
+			decomposition:    decomposition,
 		}
 	}
 
@@ -979,6 +1093,9 @@ func createUCDEX(ucd *UCD) (ucdex, error) {
 	if err = updateKanaRelation(m); err != nil {
 		return nil, err
 	}
+	if err = updateSizeRelation(m); err != nil {
+		return nil, err
+	}
 	if err = updateAdditionalRefList(m); err != nil {
 		return nil, err
 	}
@@ -1033,15 +1150,23 @@ func escapeChar(r rune) string {
 	}
 }
 
+func escapeString(rs []rune) string {
+	var sb strings.Builder
+	for _, r := range rs {
+		sb.WriteString(escapeChar(r))
+	}
+	return sb.String()
+}
+
 func writeUCDEX(f io.Writer, m ucdex) {
 	fmt.Fprint(f, "Blk,Na,Age,Gc,codepoint,ch,category,char_case,compat_case,ch,")
-	fmt.Fprint(f, "char_width,compat_width,ch,voicing,compat_vs,ch,compat_svs,ch\n")
+	fmt.Fprint(f, "char_width,compat_width,ch,voicing,compat_vs,ch,compat_svs,ch,compat_size,ch,decomposition\n")
 
 	for _, b := range blockRanges {
 		for i := b.first; i <= b.last; i++ {
 			if c, ok := m[i]; !ok {
 				fmt.Fprintf(f, ",(not present in the ucd),,,U+%04X,,ctUndefined,"+
-					"ccUndefined,,,cwUndefined,,,vcUndefined,,,,\n", i)
+					"ccUndefined,,,cwUndefined,,,vcUndefined,,,,,,,\n", i)
 			} else {
 				fmt.Fprintf(f, "%s", c.blk)
 				fmt.Fprintf(f, ",%s", c.na)
@@ -1061,6 +1186,9 @@ func writeUCDEX(f io.Writer, m ucdex) {
 				fmt.Fprintf(f, ",%s", escapeChar(c.compatVoiced))
 				fmt.Fprintf(f, ",%s", formatRune(c.compatSemivoiced))
 				fmt.Fprintf(f, ",%s", escapeChar(c.compatSemivoiced))
+				fmt.Fprintf(f, ",%s", formatRune(c.compatSize))
+				fmt.Fprintf(f, ",%s", escapeChar(c.compatSize))
+				fmt.Fprintf(f, ",%s", escapeString(c.decomposition))
 				fmt.Fprintln(f, "")
 			}
 		}
@@ -1106,6 +1234,7 @@ type unichar struct {
 	compatWidth      rune   // A width compatible character (Narrow-Wide)
 	compatVoiced     rune   // A voiced sound compatible character (Unvoiced-Voiced)
 	compatSemivoiced rune   // A semi-voiced sound compatible character (Unvoiced-Semivoiced)
+	compatSize       rune   // A size compatible character (Small-Large)
 }
 
 type unichars []unichar
@@ -1145,6 +1274,7 @@ func generate(f io.Writer, m ucdex, genname string) {
 				fmt.Fprintf(f, ",%q", i)
 				fmt.Fprintf(f, ",%q", i)
 				fmt.Fprintf(f, ",%q", i)
+				fmt.Fprintf(f, ",%q", i)
 			} else {
 				fmt.Fprintf(f, "0x%04X", c.codepoint)
 				fmt.Fprintf(f, ",%s", categories.name(c.category))
@@ -1155,12 +1285,24 @@ func generate(f io.Writer, m ucdex, genname string) {
 				fmt.Fprintf(f, ",%s", nonZeroOrElse(c.compatWidth, c.codepoint))
 				fmt.Fprintf(f, ",%s", nonZeroOrElse(c.compatVoiced, c.codepoint))
 				fmt.Fprintf(f, ",%s", nonZeroOrElse(c.compatSemivoiced, c.codepoint))
+				fmt.Fprintf(f, ",%s", nonZeroOrElse(c.compatSize, c.codepoint))
 			}
 			fmt.Fprintf(f, "}, // 0x%04X %s\n", i, string([]rune{i}))
 
 		}
 		fmt.Fprint(f, "}\n\n")
 	}
+
+	fmt.Fprintln(f, "// The compatibility decompositions of the enclosed and compatibility characters")
+	fmt.Fprintln(f, "var decompTable = map[rune]string{")
+	for _, b := range blockRanges {
+		for i := b.first; i <= b.last; i++ {
+			if c, ok := m[i]; ok && len(c.decomposition) > 0 {
+				fmt.Fprintf(f, "\t0x%04X: %q, // %s\n", i, string(c.decomposition), string([]rune{i}))
+			}
+		}
+	}
+	fmt.Fprint(f, "}\n")
 }
 
 // Generate generates the array of UCDEX (Go source code)
@@ -0,0 +1,99 @@
+package gaga
+
+import (
+	"unicode/utf8"
+)
+
+// HeadingStyle is the style of the index headings of IndexHeading.
+type HeadingStyle int
+
+// Constants to identify the styles of the index headings.
+const (
+	// HeadingLetter is the first letter of the gojūon row.
+	// Example: "ガイド" => "か"
+	HeadingLetter HeadingStyle = iota
+
+	// HeadingRowName is the name of the gojūon row.
+	// Example: "ガイド" => "か行"
+	HeadingRowName
+)
+
+// gojuonRows are the letters of each row of the gojūon table.
+var gojuonRows = []string{
+	"あいうえお",
+	"かきくけこ",
+	"さしすせそ",
+	"たちつてと",
+	"なにぬねの",
+	"はひふへほ",
+	"まみむめも",
+	"やゆよ",
+	"らりるれろ",
+	"わゐゑをん",
+}
+
+// gojuonRowMap maps each letter of the gojūon table to its row.
+var gojuonRowMap = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for _, row := range gojuonRows {
+		first, _ := utf8.DecodeRuneInString(row)
+		for _, r := range row {
+			m[r] = first
+		}
+	}
+	return m
+}()
+
+// headingNormalizer normalizes the text into the large unvoiced Hiragana
+// of the gojūon table for IndexHeading.
+var headingNormalizer = func() *Normalizer {
+	n, err := Norm(KanaToHiragana | SmallKanaToLarge | StripVoicing |
+		LatinToNarrow | AlphaToUpper)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}()
+
+// gojuonLetter returns the letter of the gojūon table that the kana r is
+// written with, ignoring its script, width, voicing and size.
+func gojuonLetter(r rune) rune {
+	r, _ = utf8.DecodeRuneInString(headingNormalizer.Rune(r))
+	return r
+}
+
+// Row returns the first letter of the gojūon row (gyō) of the kana r,
+// such as [か] for [が], [カ] and [ｶ], or false if the r is not in the
+// gojūon table. The [ん] belongs to the row of [わ].
+func Row(r rune) (rune, bool) {
+	row, ok := gojuonRowMap[gojuonLetter(r)]
+	return row, ok
+}
+
+// Column returns the vowel of the gojūon column (dan) of the kana r,
+// such as [あ] for [が], [カ] and [ゃ], or false if the r is not in the
+// gojūon table or has no vowel, such as [ん].
+func Column(r rune) (rune, bool) {
+	return kanaVowel(gojuonLetter(r))
+}
+
+// IndexHeading returns the index heading of the s in the style, which is
+// the gojūon row of the first letter of the s if it is kana, such as [か]
+// or [か行] for "ガイド". If the s begins with a Latin letter, it returns
+// the letter in upper case, and otherwise, it returns "".
+func IndexHeading(s string, style HeadingStyle) string {
+	r, _ := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return ""
+	}
+	if row, ok := Row(r); ok {
+		if style == HeadingRowName {
+			return string(row) + "行"
+		}
+		return string(row)
+	}
+	if c, ok := findUnichar(gojuonLetter(r)); ok && c.category == ctLatinLetter {
+		return string(c.codepoint)
+	}
+	return ""
+}
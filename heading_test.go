@@ -0,0 +1,73 @@
+package gaga
+
+import (
+	"testing"
+)
+
+type IndexHeadingTest struct {
+	style HeadingStyle
+	in    string
+	out   string
+}
+
+var indexheadingtests = []IndexHeadingTest{
+	0:  {HeadingLetter, "", ""},
+	1:  {HeadingLetter, "あおき", "あ"},
+	2:  {HeadingLetter, "ガイド", "か"},
+	3:  {HeadingRowName, "ガイド", "か行"},
+	4:  {HeadingLetter, "ｶﾞｲﾄﾞ", "か"},
+	5:  {HeadingLetter, "ぱんだ", "は"},
+	6:  {HeadingLetter, "ゃ", "や"},
+	7:  {HeadingLetter, "ヴァイオリン", "あ"},
+	8:  {HeadingLetter, "ヲ", "わ"},
+	9:  {HeadingRowName, "んぐ", "わ行"},
+	10: {HeadingLetter, "ㇰ", "か"},
+	11: {HeadingLetter, "ゐど", "わ"},
+	12: {HeadingLetter, "apple", "A"},
+	13: {HeadingRowName, "ｂｅｅ", "B"},
+	14: {HeadingLetter, "漢字", ""},
+	15: {HeadingLetter, "123", ""},
+	16: {HeadingLetter, "\xff", ""},
+}
+
+func TestIndexHeading(t *testing.T) {
+	for i, tt := range indexheadingtests {
+		if have := IndexHeading(tt.in, tt.style); have != tt.out {
+			t.Errorf("#%d IndexHeading(%q, %d) = %q, want: %q", i, tt.in, tt.style, have, tt.out)
+		}
+	}
+}
+
+type RowColumnTest struct {
+	in          rune
+	row, column rune // 0 if not found
+}
+
+var rowcolumntests = []RowColumnTest{
+	0:  {'あ', 'あ', 'あ'},
+	1:  {'が', 'か', 'あ'},
+	2:  {'ジ', 'さ', 'い'},
+	3:  {'ｯ', 'た', 'う'},
+	4:  {'ぺ', 'は', 'え'},
+	5:  {'ょ', 'や', 'お'},
+	6:  {'ゆ', 'や', 'う'},
+	7:  {'を', 'わ', 'お'},
+	8:  {'ん', 'わ', 0},
+	9:  {'ー', 0, 0},
+	10: {'a', 0, 0},
+	11: {'漢', 0, 0},
+	12: {'ヰ', 'わ', 'い'},
+}
+
+func TestRowColumn(t *testing.T) {
+	for i, tt := range rowcolumntests {
+		row, ok := Row(tt.in)
+		if ok != (tt.row != 0) || row != tt.row {
+			t.Errorf("#%d Row(%q) = %q, %v, want: %q", i, tt.in, row, ok, tt.row)
+		}
+		column, ok := Column(tt.in)
+		if ok != (tt.column != 0) || column != tt.column {
+			t.Errorf("#%d Column(%q) = %q, %v, want: %q", i, tt.in, column, ok, tt.column)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package gaga
+
+import (
+	"unicode/utf8"
+)
+
+// input is the text to be normalized, which is either a string or
+// a byte slice. It lets the normalizer handle both of them without
+// converting one into the other.
+type input struct {
+	str   string
+	bytes []byte
+
+	// The context of the rune being normalized and that of the rune at
+	// nextPos, which are tracked only for the context-dependent flags.
+	nextPos      int
+	ctx, nextCtx normCtx
+}
+
+func inputString(s string) input {
+	return input{str: s}
+}
+
+func inputBytes(b []byte) input {
+	return input{bytes: b}
+}
+
+func (in *input) len() int {
+	if in.bytes == nil {
+		return len(in.str)
+	}
+	return len(in.bytes)
+}
+
+func (in *input) fullRune(p int) bool {
+	if in.bytes == nil {
+		return utf8.FullRuneInString(in.str[p:])
+	}
+	return utf8.FullRune(in.bytes[p:])
+}
+
+func (in *input) decodeRune(p int) (rune, int) {
+	if in.bytes == nil {
+		return utf8.DecodeRuneInString(in.str[p:])
+	}
+	return utf8.DecodeRune(in.bytes[p:])
+}
+
+// equal reports whether in[p:p+len(b)] is equal to b.
+func (in *input) equal(p int, b []byte) bool {
+	if p+len(b) > in.len() {
+		return false
+	}
+	if in.bytes == nil {
+		return in.str[p:p+len(b)] == string(b)
+	}
+	return string(in.bytes[p:p+len(b)]) == string(b)
+}
+
+func (in *input) appendSlice(dst []byte, b, e int) []byte {
+	if in.bytes == nil {
+		return append(dst, in.str[b:e]...)
+	}
+	return append(dst, in.bytes[b:e]...)
+}
+
+// setCtx sets the context of the rune at the beginning of the in, that
+// is, the context carried over from the previous input.
+func (in *input) setCtx(ctx normCtx) {
+	in.ctx, in.nextCtx = ctx, ctx
+}
+
+// ctxAt returns the context of the rune at p, which must be the position
+// of the rune that has just been normalized or the one that follows it.
+func (in *input) ctxAt(p int) normCtx {
+	if p == in.nextPos {
+		return in.nextCtx
+	}
+	return in.ctx
+}
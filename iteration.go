@@ -0,0 +1,94 @@
+package gaga
+
+import (
+	"unicode"
+)
+
+// isIterationMark reports whether r is an iteration mark.
+func isIterationMark(r rune) bool {
+	switch r {
+	case 'ゝ', 'ゞ', 'ヽ', 'ヾ',
+		'々', // IDEOGRAPHIC ITERATION MARK
+		'〻': // VERTICAL IDEOGRAPHIC ITERATION MARK
+		return true
+	default:
+		return false
+	}
+}
+
+// isKanji reports whether r is a kanji that an iteration mark may repeat.
+func isKanji(r rune) bool {
+	return unicode.Is(unicode.Han, r) && !isIterationMark(r)
+}
+
+// findKanaLetter returns the kana letter r that an iteration mark may
+// repeat, or false if the r is not such a letter.
+func findKanaLetter(r rune) (*unichar, bool) {
+	c, ok := findUnichar(r)
+	if !ok || c.category != ctKanaLetter ||
+		isIterationMark(r) || isProlongedSoundMark(r) {
+		return nil, false
+	}
+	return c, true
+}
+
+// iterate returns the rune that the iteration mark r stands for after
+// the rune last, or false if the r cannot repeat the last.
+// The [ゝ] and [ヽ] repeat the unvoiced letter, while the [ゞ] and [ヾ]
+// repeat the voiced one.
+func iterate(r, last rune) (rune, bool) {
+	switch r {
+	case '々', '〻':
+		return last, isKanji(last)
+	case 'ゝ', 'ヽ', 'ゞ', 'ヾ':
+		c, ok := findKanaLetter(last)
+		if !ok {
+			return 0, false
+		}
+		c = c.toUnvoicedC()
+		if r == 'ゝ' || r == 'ヽ' {
+			return c.codepoint, true
+		}
+		c = c.toWideC()
+		if !c.existsCompatVoiced() {
+			return 0, false
+		}
+		return c.compatVoiced, true
+	default:
+		return 0, false
+	}
+}
+
+// iterationMark returns the iteration mark that the rune cur is replaced
+// with after the rune prev, or false if the cur does not repeat the prev.
+// Both of the runes must be normalized ones.
+func iterationMark(prev, cur rune) (rune, bool) {
+	if isKanji(cur) {
+		return '々', cur == prev
+	}
+	pc, ok := findKanaLetter(prev)
+	if !ok {
+		return 0, false
+	}
+	cc, ok := findKanaLetter(cur)
+	if !ok {
+		return 0, false
+	}
+	// There are no half-width iteration marks, and no marks for the
+	// semi-voiced letters.
+	if pc.charWidth != cwWide || cc.charWidth != cwWide ||
+		pc.charCase != cc.charCase || cc.voicing == vcSemivoiced ||
+		pc.toUnvoicedC().codepoint != cc.toUnvoicedC().codepoint {
+		return 0, false
+	}
+	switch {
+	case cc.charCase == ccHiragana && cc.voicing == vcVoiced:
+		return 'ゞ', true
+	case cc.charCase == ccHiragana:
+		return 'ゝ', true
+	case cc.voicing == vcVoiced:
+		return 'ヾ', true
+	default:
+		return 'ヽ', true
+	}
+}
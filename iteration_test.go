@@ -0,0 +1,99 @@
+package gaga
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+)
+
+type IterationMarkTest struct {
+	flag NormFlag
+	in   string
+	out  string
+}
+
+var iterationmarktests = []IterationMarkTest{
+	0:  {ExpandIterationMark, "", ""},
+	1:  {ExpandIterationMark, "いすゞ", "いすず"},
+	2:  {ExpandIterationMark, "こゝろ", "こころ"},
+	3:  {ExpandIterationMark, "カヾ", "カガ"},
+	4:  {ExpandIterationMark, "バヽ", "バハ"},
+	5:  {ExpandIterationMark, "時々", "時時"},
+	6:  {ExpandIterationMark, "時〻", "時時"},
+	7:  {ExpandIterationMark, "人々々", "人人人"},
+	8:  {ExpandIterationMark, "ゝ々", "ゝ々"},
+	9:  {ExpandIterationMark, "あゞ", "あゞ"},
+	10: {ExpandIterationMark, "a々ゝ", "a々ゝ"},
+	11: {ExpandIterationMark, "か゛ゝゞ", "か゛かが"},
+	12: {ExpandIterationMark, "ｶヾ", "ｶガ"},
+	13: {ExpandIterationMark | Fold, "ｶヾ", "カガ"},
+	14: {ExpandIterationMark | KanaToNarrow, "カヾ", "ｶｶﾞ"},
+	15: {ExpandIterationMark | KanaToHiragana, "カヾ", "かが"},
+	16: {ExpandIterationMark | StripVoicing, "いすゞ", "いすす"},
+	17: {ExpandIterationMark | ProlongedSoundMarkByContext, "カヽ―", "カカー"},
+	18: {CollapseIterationMark, "", ""},
+	19: {CollapseIterationMark, "いすず", "いすゞ"},
+	20: {CollapseIterationMark, "こころ", "こゝろ"},
+	21: {CollapseIterationMark, "カガ", "カヾ"},
+	22: {CollapseIterationMark, "時時", "時々"},
+	23: {CollapseIterationMark, "人人人", "人々々"},
+	24: {CollapseIterationMark, "はぱ", "はぱ"},
+	25: {CollapseIterationMark, "かカ", "かカ"},
+	26: {CollapseIterationMark, "ｶｶ", "ｶｶ"},
+	27: {CollapseIterationMark, "時 時", "時 時"},
+	28: {CollapseIterationMark | Fold, "ｶｶﾞ", "カヾ"},
+	29: {CollapseIterationMark | KanaToHiragana, "カか", "かゝ"},
+	30: {Fold, "いすゞ時々", "いすゞ時々"},
+}
+
+func TestIterationMark(t *testing.T) {
+	for i, tt := range iterationmarktests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if have := n.String(tt.in); have != tt.out {
+			t.Errorf("#%d %s, String(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, have, tt.out)
+		}
+		if have := string(n.Bytes([]byte(tt.in))); have != tt.out {
+			t.Errorf("#%d %s, Bytes(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, have, tt.out)
+		}
+		if have, _ := n.StringWithOffsets(tt.in); have != tt.out {
+			t.Errorf("#%d %s, StringWithOffsets(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, have, tt.out)
+		}
+		if have := n.IsNormalized(tt.in); have != (tt.in == tt.out) {
+			t.Errorf("#%d %s, IsNormalized(%q) = %v", i, tt.flag, tt.in, have)
+		}
+	}
+}
+
+func TestIterationMark_Stream(t *testing.T) {
+	for i, tt := range iterationmarktests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, err := ioutil.ReadAll(n.NewReader(iotest.OneByteReader(strings.NewReader(tt.in))))
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, NewReader(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, out, tt.out)
+		}
+		r := transform.NewReader(iotest.OneByteReader(strings.NewReader(tt.in)), n.Transformer())
+		out, err = ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, Transformer(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, out, tt.out)
+		}
+	}
+}
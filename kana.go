@@ -0,0 +1,190 @@
+package gaga
+
+import (
+	"strings"
+)
+
+// KanaScript is the script of the kana that Kana converts romaji into.
+type KanaScript int
+
+// Constants to identify the kana scripts.
+const (
+	// Hiragana is the full-width Hiragana.
+	// Example: "kyoutoha" => "きょうとは"
+	Hiragana KanaScript = iota
+
+	// Katakana is the full-width Katakana.
+	// Example: "kyoutoha" => "キョウトハ"
+	Katakana
+
+	// NarrowKatakana is the half-width Katakana.
+	// Example: "kyoutoha" => "ｷｮｳﾄﾊ"
+	NarrowKatakana
+)
+
+// imeTable maps the romaji typed on an IME to the Hiragana.
+var imeTable = map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"ca": "か", "ci": "し", "cu": "く", "ce": "せ", "co": "こ",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"sa": "さ", "si": "し", "shi": "し", "su": "す", "se": "せ", "so": "そ",
+	"za": "ざ", "zi": "じ", "ji": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"ta": "た", "ti": "ち", "chi": "ち", "tu": "つ", "tsu": "つ", "te": "て", "to": "と",
+	"da": "だ", "di": "ぢ", "du": "づ", "de": "で", "do": "ど",
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"ha": "は", "hi": "ひ", "hu": "ふ", "fu": "ふ", "he": "へ", "ho": "ほ",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"ya": "や", "yu": "ゆ", "ye": "いぇ", "yo": "よ",
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"wa": "わ", "wi": "うぃ", "we": "うぇ", "wo": "を",
+	"va": "ゔぁ", "vi": "ゔぃ", "vu": "ゔ", "ve": "ゔぇ", "vo": "ゔぉ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	"tsa": "つぁ", "tsi": "つぃ", "tse": "つぇ", "tso": "つぉ",
+	"thi": "てぃ", "thu": "てゅ", "dhi": "でぃ", "dhu": "でゅ",
+	"twu": "とぅ", "dwu": "どぅ",
+
+	"kya": "きゃ", "kyi": "きぃ", "kyu": "きゅ", "kye": "きぇ", "kyo": "きょ",
+	"gya": "ぎゃ", "gyi": "ぎぃ", "gyu": "ぎゅ", "gye": "ぎぇ", "gyo": "ぎょ",
+	"sya": "しゃ", "syi": "しぃ", "syu": "しゅ", "sye": "しぇ", "syo": "しょ",
+	"sha": "しゃ", "shu": "しゅ", "she": "しぇ", "sho": "しょ",
+	"zya": "じゃ", "zyi": "じぃ", "zyu": "じゅ", "zye": "じぇ", "zyo": "じょ",
+	"ja": "じゃ", "ju": "じゅ", "je": "じぇ", "jo": "じょ",
+	"jya": "じゃ", "jyi": "じぃ", "jyu": "じゅ", "jye": "じぇ", "jyo": "じょ",
+	"tya": "ちゃ", "tyi": "ちぃ", "tyu": "ちゅ", "tye": "ちぇ", "tyo": "ちょ",
+	"cya": "ちゃ", "cyi": "ちぃ", "cyu": "ちゅ", "cye": "ちぇ", "cyo": "ちょ",
+	"cha": "ちゃ", "chu": "ちゅ", "che": "ちぇ", "cho": "ちょ",
+	"dya": "ぢゃ", "dyi": "ぢぃ", "dyu": "ぢゅ", "dye": "ぢぇ", "dyo": "ぢょ",
+	"nya": "にゃ", "nyi": "にぃ", "nyu": "にゅ", "nye": "にぇ", "nyo": "にょ",
+	"hya": "ひゃ", "hyi": "ひぃ", "hyu": "ひゅ", "hye": "ひぇ", "hyo": "ひょ",
+	"bya": "びゃ", "byi": "びぃ", "byu": "びゅ", "bye": "びぇ", "byo": "びょ",
+	"pya": "ぴゃ", "pyi": "ぴぃ", "pyu": "ぴゅ", "pye": "ぴぇ", "pyo": "ぴょ",
+	"fya": "ふゃ", "fyu": "ふゅ", "fyo": "ふょ",
+	"mya": "みゃ", "myi": "みぃ", "myu": "みゅ", "mye": "みぇ", "myo": "みょ",
+	"rya": "りゃ", "ryi": "りぃ", "ryu": "りゅ", "rye": "りぇ", "ryo": "りょ",
+
+	"xa": "ぁ", "xi": "ぃ", "xu": "ぅ", "xe": "ぇ", "xo": "ぉ",
+	"la": "ぁ", "li": "ぃ", "lu": "ぅ", "le": "ぇ", "lo": "ぉ",
+	"xya": "ゃ", "xyu": "ゅ", "xyo": "ょ", "lya": "ゃ", "lyu": "ゅ", "lyo": "ょ",
+	"xtu": "っ", "xtsu": "っ", "ltu": "っ", "ltsu": "っ",
+	"xwa": "ゎ", "lwa": "ゎ", "xka": "ゕ", "xke": "ゖ", "lka": "ゕ", "lke": "ゖ",
+
+	"nn": "ん", "n'": "ん", "xn": "ん",
+	"-": "ー",
+}
+
+// imePrefixes is the set of the proper prefixes of the keys of the
+// imeTable, after which more romaji may follow.
+var imePrefixes = func() map[string]bool {
+	m := make(map[string]bool)
+	for k := range imeTable {
+		for i := 1; i < len(k); i++ {
+			m[k[:i]] = true
+		}
+	}
+	m["tc"] = true // [tch] makes [っ][ch]
+	return m
+}()
+
+const imeTableMaxLen = 4 // len("xtsu")
+
+// kanaNormalizer normalizes the romaji for Kana.
+var kanaNormalizer = func() *Normalizer {
+	n, err := Norm(LatinToNarrow | AlphaToLower)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}()
+
+// kanaScriptNormalizers converts the Hiragana into each KanaScript.
+var kanaScriptNormalizers = func() map[KanaScript]*Normalizer {
+	m := make(map[KanaScript]*Normalizer)
+	for to, flag := range map[KanaScript]NormFlag{
+		Katakana:       KanaToWideKatakana,
+		NarrowKatakana: KanaToNarrowKatakana,
+	} {
+		n, err := Norm(flag)
+		if err != nil {
+			panic(err)
+		}
+		m[to] = n
+	}
+	return m
+}()
+
+func isKanaVowel(c byte) bool {
+	return strings.IndexByte("aiueo", c) >= 0
+}
+
+func isKanaConsonant(c byte) bool {
+	return 'a' <= c && c <= 'z' && !isKanaVowel(c)
+}
+
+// Kana converts the romaji in the s into kana of the script to, in the
+// same way as the romaji input of an IME, in which [nn] and [n'] make
+// [ん], a doubled consonant makes [っ], and [-] makes [ー].
+// The s is normalized into half-width lower case letters first. A [n] at
+// the end of the s is converted into [ん], and the other romaji that
+// make no kana are left as they are.
+func Kana(s string, to KanaScript) string {
+	kana, pending := romajiToKana(s, to, true)
+	return kana + pending
+}
+
+// KanaIncremental is like Kana, but leaves the romaji at the end of the
+// s that may still make kana with the romaji typed next, such as [ky] or
+// [n], as the pending, so that the s can be converted keystroke by
+// keystroke, by passing the pending followed by the next keystroke.
+func KanaIncremental(s string, to KanaScript) (kana, pending string) {
+	return romajiToKana(s, to, false)
+}
+
+func romajiToKana(s string, to KanaScript, final bool) (kana, pending string) {
+	s = kanaNormalizer.String(s)
+	var sb strings.Builder
+	p := 0
+	for p < len(s) {
+		rest := s[p:]
+		size := 0
+		for l := imeTableMaxLen; l > 0; l-- {
+			if l <= len(rest) {
+				if k, ok := imeTable[rest[:l]]; ok {
+					sb.WriteString(k)
+					size = l
+					break
+				}
+			}
+		}
+		switch {
+		case size > 0:
+			// The romaji makes a kana.
+		case len(rest) < imeTableMaxLen && imePrefixes[rest] && !final:
+			// More romaji may follow.
+			return toKanaScript(sb.String(), to), rest
+		case len(rest) >= 2 && rest[0] == rest[1] && isKanaConsonant(rest[0]),
+			strings.HasPrefix(rest, "tch"):
+			sb.WriteString("っ")
+			size = 1
+		case rest[0] == 'n' && (len(rest) == 1 || !isKanaVowel(rest[1]) && rest[1] != 'y'):
+			sb.WriteString("ん")
+			size = 1
+		default:
+			sb.WriteByte(rest[0])
+			size = 1
+		}
+		p += size
+	}
+	return toKanaScript(sb.String(), to), ""
+}
+
+// toKanaScript converts the Hiragana in the s into the script to.
+func toKanaScript(s string, to KanaScript) string {
+	n, ok := kanaScriptNormalizers[to]
+	if !ok {
+		return s
+	}
+	return n.String(s)
+}
@@ -0,0 +1,92 @@
+package gaga
+
+import (
+	"testing"
+)
+
+type KanaTest struct {
+	to  KanaScript
+	in  string
+	out string
+}
+
+var kanatests = []KanaTest{
+	0:  {Hiragana, "", ""},
+	1:  {Hiragana, "kyoutoha", "きょうとは"},
+	2:  {Katakana, "kyoutoha", "キョウトハ"},
+	3:  {NarrowKatakana, "kyoutoha", "ｷｮｳﾄﾊ"},
+	4:  {Hiragana, "konnnichiha", "こんにちは"},
+	5:  {Hiragana, "kan'i", "かんい"},
+	6:  {Hiragana, "kanji", "かんじ"},
+	7:  {Hiragana, "shinbun", "しんぶん"},
+	8:  {Hiragana, "sinbun", "しんぶん"},
+	9:  {Hiragana, "kitte", "きって"},
+	10: {Hiragana, "matcha", "まっちゃ"},
+	11: {Hiragana, "macca", "まっか"},
+	12: {Hiragana, "xtuxtsultultsu", "っっっっ"},
+	13: {Hiragana, "xyalyoxwa", "ゃょゎ"},
+	14: {Katakana, "ra-men", "ラーメン"},
+	15: {Katakana, "vaiorin", "ヴァイオリン"},
+	16: {Katakana, "fairu", "ファイル"},
+	17: {Hiragana, "ＫＹＯＵＴＯ", "きょうと"},
+	18: {Hiragana, "n", "ん"},
+	19: {Hiragana, "ky", "ky"},
+	20: {Hiragana, "qwerty", "qうぇrty"},
+	21: {Hiragana, "tyotto", "ちょっと"},
+	22: {Hiragana, "jyanken", "じゃんけん"},
+	23: {Hiragana, "dhi-zeru", "でぃーぜる"},
+	24: {Hiragana, "sanpo.", "さんぽ."},
+}
+
+func TestKana(t *testing.T) {
+	for i, tt := range kanatests {
+		if have := Kana(tt.in, tt.to); have != tt.out {
+			t.Errorf("#%d Kana(%q, %d)\n\thave: %q\n\twant: %q", i, tt.in, tt.to, have, tt.out)
+		}
+	}
+}
+
+type KanaIncrementalTest struct {
+	in      string
+	out     string
+	pending string
+}
+
+var kanaincrementaltests = []KanaIncrementalTest{
+	0:  {"", "", ""},
+	1:  {"k", "", "k"},
+	2:  {"ky", "", "ky"},
+	3:  {"kyo", "きょ", ""},
+	4:  {"n", "", "n"},
+	5:  {"kan", "か", "n"},
+	6:  {"kann", "かん", ""},
+	7:  {"kank", "かん", "k"},
+	8:  {"kk", "っ", "k"},
+	9:  {"xt", "", "xt"},
+	10: {"ts", "", "ts"},
+	11: {"tsu", "つ", ""},
+	12: {"kz", "k", "z"},
+}
+
+func TestKanaIncremental(t *testing.T) {
+	for i, tt := range kanaincrementaltests {
+		out, pending := KanaIncremental(tt.in, Hiragana)
+		if out != tt.out || pending != tt.pending {
+			t.Errorf("#%d KanaIncremental(%q)\n\thave: %q, %q\n\twant: %q, %q",
+				i, tt.in, out, pending, tt.out, tt.pending)
+		}
+	}
+	// Typing the keys one by one must give the same kana as the whole.
+	for i, tt := range kanatests {
+		var kana, pending string
+		for _, r := range tt.in {
+			var k string
+			k, pending = KanaIncremental(pending+string(r), tt.to)
+			kana += k
+		}
+		kana += Kana(pending, tt.to)
+		if kana != tt.out {
+			t.Errorf("#%d KanaIncremental(%q) one by one\n\thave: %q\n\twant: %q", i, tt.in, kana, tt.out)
+		}
+	}
+}
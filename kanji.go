@@ -0,0 +1,28 @@
+package gaga
+
+//go:generate go run gen/genkanjitables.go -output kanji_tables.go
+
+// Ideographic Variation Selectors (VS17-VS256)
+const (
+	ivsFirst rune = 0xE0100
+	ivsLast  rune = 0xE01EF
+)
+
+// isIVS reports whether r is an ideographic variation selector.
+func isIVS(r rune) bool {
+	return ivsFirst <= r && r <= ivsLast
+}
+
+// normalizeKanji normalizes the r, which is not in the tables, according
+// to the KanjiVariantFold and StripIVS of the f.
+func (f NormFlag) normalizeKanji(r rune) (rune, vom) {
+	if f.has(KanjiVariantFold) {
+		if std, ok := kanjiVariantTable[r]; ok {
+			return std, vmNone
+		}
+	}
+	if f.has(StripIVS) && isIVS(r) {
+		return noRune, vmNone
+	}
+	return r, vmNone
+}
@@ -0,0 +1,108 @@
+package gaga
+
+import (
+	"unicode/utf8"
+)
+
+// modernKanaList maps the historical kana letters to their modern ones
+// for the ModernizeKana. The Katakana Phonetic Extensions are mapped to
+// their large letters by the compatSize of the tables.
+var modernKanaList = map[rune]rune{
+	'ゐ': 'い', // U+3090 HIRAGANA LETTER WI
+	'ゑ': 'え', // U+3091 HIRAGANA LETTER WE
+	'ヰ': 'イ', // U+30F0 KATAKANA LETTER WI
+	'ヱ': 'エ', // U+30F1 KATAKANA LETTER WE
+}
+
+// vaToBaList maps the letters with the voiced sound mark for the
+// ModernizeVaToBa.
+var vaToBaList = map[rune]rune{
+	'ヷ': 'バ', // U+30F7 KATAKANA LETTER VA
+	'ヸ': 'ビ', // U+30F8 KATAKANA LETTER VI
+	'ヹ': 'ベ', // U+30F9 KATAKANA LETTER VE
+	'ヺ': 'ボ', // U+30FA KATAKANA LETTER VO
+}
+
+// modernStringList maps the historical kana letters that have no modern
+// single letter for the ModernizeKana.
+var modernStringList = map[rune]string{
+	'ヷ': "ヴァ", // U+30F7 KATAKANA LETTER VA
+	'ヸ': "ヴィ", // U+30F8 KATAKANA LETTER VI
+	'ヹ': "ヴェ", // U+30F9 KATAKANA LETTER VE
+	'ヺ': "ヴォ", // U+30FA KATAKANA LETTER VO
+	'ゟ': "より", // U+309F HIRAGANA DIGRAPH YORI
+	'ヿ': "コト", // U+30FF KATAKANA DIGRAPH KOTO
+}
+
+// for Hiragana-Katakana letters.
+func (c *unichar) toModernC(f NormFlag) *unichar {
+	if f.has(ModernizeVaToBa) {
+		if r, ok := vaToBaList[c.codepoint]; ok {
+			return findUnicharForSure(r)
+		}
+	}
+	if !f.has(ModernizeKana) {
+		return c
+	}
+	if r, ok := modernKanaList[c.codepoint]; ok {
+		return findUnicharForSure(r)
+	}
+	if kanaExtFirst <= c.codepoint && c.codepoint <= kanaExtLast {
+		return c.toLargeC()
+	}
+	return c
+}
+
+// modernString returns the modern letters that the r is replaced with
+// according to the f, or false if the r has no such letters.
+func (f NormFlag) modernString(r rune) (string, bool) {
+	if !f.has(ModernizeKana) {
+		return "", false
+	}
+	if _, ok := vaToBaList[r]; ok && f.has(ModernizeVaToBa) {
+		return "", false
+	}
+	s, ok := modernStringList[r]
+	return s, ok
+}
+
+// appendRunes normalizes the s rune by rune according to the f, and
+// appends the result to the dst. The runes of the s are never combined
+// with each other.
+func (f NormFlag) appendRunes(dst []byte, s string) []byte {
+	var buf [utf8.UTFMax * 2]byte
+	for _, r := range s {
+		r2, m := f.normalizeRune(r)
+		dst = append(dst, buf[:encodeNorm(buf[:], r2, m)]...)
+	}
+	return dst
+}
+
+// compileModern normalizes the modern letters of modernStringList
+// according to the flag.
+func (n *Normalizer) compileModern() {
+	if !n.flag.has(ModernizeKana) {
+		n.modernOut = nil
+		return
+	}
+	n.modernOut = make(map[rune][]byte, len(modernStringList))
+	for r := range modernStringList {
+		if s, ok := n.flag.modernString(r); ok {
+			n.modernOut[r] = n.flag.appendRunes(nil, s)
+		}
+	}
+}
+
+// modern returns the normalized modern letters that the r is replaced
+// with, or false if the r has no such letters.
+func (n *Normalizer) modern(r rune) ([]byte, bool) {
+	s, ok := n.flag.modernString(r)
+	if !ok {
+		return nil, false
+	}
+	if out, ok := n.modernOut[r]; ok {
+		return out, true
+	}
+	// The Normalizer was not created by Norm.
+	return n.flag.appendRunes(nil, s), true
+}
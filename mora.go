@@ -0,0 +1,67 @@
+package gaga
+
+import (
+	"strings"
+)
+
+// moraNormalizer normalizes the text into Hiragana for Morae.
+var moraNormalizer = func() *Normalizer {
+	n, err := Norm(KanaToHiragana | ComposeVom)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}()
+
+// isYouonSmall reports whether r is a small letter that makes a single
+// mora together with the letter preceding it, such as [ゃ] of [き][ゃ].
+func isYouonSmall(r rune) bool {
+	return strings.ContainsRune("ぁぃぅぇぉゃゅょゎ", r)
+}
+
+// isSpecialMora reports whether r is a letter that makes a mora by
+// itself but cannot be followed by a small letter of a youon.
+func isSpecialMora(r rune) bool {
+	return r == 'っ' || r == 'ん' || isProlongedSoundMark(r)
+}
+
+func isKanaLetter(r rune) bool {
+	c, ok := findUnichar(r)
+	return ok && c.category == ctKanaLetter
+}
+
+// Morae splits the kana in the s into morae. A letter followed by small
+// letters such as [き][ゃ] is a mora, and [っ], [ん] and [ー] are a mora
+// each. The s is normalized with the KanaToHiragana|ComposeVom first, so
+// that the kana of any width or case is accepted, and the morae are
+// returned in Hiragana, in which [ｰ] is widened to [ー]. The characters
+// other than kana, such as kanji, are not counted, so the text in kanji
+// must be given in its reading.
+func Morae(s string) []string {
+	s = moraNormalizer.String(s)
+	var morae []string
+	last := rune(0) // the first letter of the last mora
+	for _, r := range s {
+		if r == 'ｰ' {
+			// KanaToHiragana leaves the half-width [ｰ] as it is, since
+			// it is not Katakana to convert into Hiragana.
+			r = 'ー'
+		}
+		switch {
+		case !isKanaLetter(r):
+			last = 0
+		case isYouonSmall(r) && last != 0 && !isSpecialMora(last):
+			morae[len(morae)-1] += string(r)
+			last = 0
+		default:
+			morae = append(morae, string(r))
+			last = r
+		}
+	}
+	return morae
+}
+
+// CountMorae returns the number of the morae in the s. See Morae.
+func CountMorae(s string) int {
+	return len(Morae(s))
+}
@@ -0,0 +1,41 @@
+package gaga
+
+import (
+	"reflect"
+	"testing"
+)
+
+type MoraeTest struct {
+	in  string
+	out []string
+}
+
+var moraetests = []MoraeTest{
+	0:  {"", nil},
+	1:  {"あいう", []string{"あ", "い", "う"}},
+	2:  {"きゃく", []string{"きゃ", "く"}},
+	3:  {"キャット", []string{"きゃ", "っ", "と"}},
+	4:  {"ｷｬｯﾄ", []string{"きゃ", "っ", "と"}},
+	5:  {"しんぶん", []string{"し", "ん", "ぶ", "ん"}},
+	6:  {"ラーメン", []string{"ら", "ー", "め", "ん"}},
+	7:  {"ファイル", []string{"ふぁ", "い", "る"}},
+	8:  {"ｶﾞｯｺｳ", []string{"が", "っ", "こ", "う"}},
+	9:  {"か゛く", []string{"が", "く"}},
+	10: {"ぁ", []string{"ぁ"}},
+	11: {"んゃ", []string{"ん", "ゃ"}},
+	12: {"き ゃ", []string{"き", "ゃ"}},
+	13: {"閑さや", []string{"さ", "や"}},
+	14: {"abc", nil},
+	15: {"ｶｰﾄﾞ", []string{"か", "ー", "ど"}},
+}
+
+func TestMorae(t *testing.T) {
+	for i, tt := range moraetests {
+		if have := Morae(tt.in); !reflect.DeepEqual(have, tt.out) {
+			t.Errorf("#%d Morae(%q)\n\thave: %q\n\twant: %q", i, tt.in, have, tt.out)
+		}
+		if have := CountMorae(tt.in); have != len(tt.out) {
+			t.Errorf("#%d CountMorae(%q) = %d, want: %d", i, tt.in, have, len(tt.out))
+		}
+	}
+}
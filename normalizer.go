@@ -1,13 +1,44 @@
 package gaga
 
 import (
-	"strings"
+	"unicode/utf8"
 )
 
 // Normalizer normalizes the input provided and returns
 // the normalized string.
 type Normalizer struct {
 	flag NormFlag
+
+	// The normalization tables compiled from the flag.
+	latin   []normEntry
+	kana    []normEntry
+	kanaExt []normEntry
+	width   []normEntry
+
+	// The custom rules and their replacements normalized with the flag.
+	rules   Rules
+	ruleOut map[rune][]byte
+
+	// The modern letters of modernStringList normalized with the flag.
+	modernOut map[rune][]byte
+
+	// The decompositions of the enclosed and compatibility characters
+	// normalized with the flag.
+	plainOut map[rune][]byte
+
+	// inContext is true if the flag has the context-dependent flags, so
+	// that the others do not track the context.
+	inContext bool
+}
+
+// noRune is the rune that normalizeRune returns for a rune to be removed.
+const noRune rune = -1
+
+// normEntry is the result of normalizing a rune.
+type normEntry struct {
+	r    rune
+	m    vom
+	base bool // the rune may be combined with the voicing modifier that follows it
 }
 
 func (n *Normalizer) maybeComposeVom(r1, r2 rune) (rune, vom, bool) {
@@ -30,6 +61,9 @@ func (n *Normalizer) maybeComposeVom(r1, r2 rune) (rune, vom, bool) {
 	// TEST_nD7FwQUW knows that normalizeRune() will definitely return
 	// a rune and the vmNone.
 	nr1, _ := n.normalizeRune(r1)
+	if n.flag.has(StripVoicing) {
+		return nr1, vmNone, true
+	}
 
 	// TEST_G9amUMTr knows that findUnichar() definitely return a rune
 	// and the ok value.
@@ -72,10 +106,17 @@ func Norm(flag NormFlag) (*Normalizer, error) {
 	if err != nil {
 		return nil, err
 	}
-	n := Normalizer{flag}
+	n := Normalizer{flag: flag}
+	n.compile()
 	return &n, nil
 }
 
+// uncompiled returns the Normalizer without the tables, which normalizes
+// with the flag directly.
+func uncompiled(flag NormFlag) Normalizer {
+	return Normalizer{flag: flag, inContext: flag.has(contextFlags)}
+}
+
 // SetFlag changes the normalization mode with
 // the newly specified flag.
 func (n *Normalizer) SetFlag(flag NormFlag) error {
@@ -84,13 +125,76 @@ func (n *Normalizer) SetFlag(flag NormFlag) error {
 		return err
 	}
 	n.flag = flag
+	n.compile()
 	return nil
 }
 
+func compileTable(flag NormFlag, table unichars) []normEntry {
+	entries := make([]normEntry, len(table))
+	for i := range table {
+		r, m := flag.normalizeRune(table[i].codepoint)
+		entries[i] = normEntry{r, m, mayComposeVom(table[i].codepoint)}
+	}
+	return entries
+}
+
+// compile compiles the flag into the normalization tables, so that
+// normalizeRune does not have to check the flag for every rune.
+func (n *Normalizer) compile() {
+	n.latin = compileTable(n.flag, latinTable)
+	n.kana = compileTable(n.flag, kanaTable)
+	n.kanaExt = compileTable(n.flag, kanaExtTable)
+	n.width = compileTable(n.flag, widthTable)
+	n.compileModern()
+	n.compilePlain()
+	n.compileRules()
+	n.inContext = n.flag.has(contextFlags)
+}
+
+// lookup returns the entry of the normalization tables for the r.
+func (n *Normalizer) lookup(r rune) normEntry {
+	switch {
+	case n.latin == nil:
+		// The Normalizer was not created by Norm.
+		r2, m := n.flag.normalizeRune(r)
+		return normEntry{r2, m, mayComposeVom(r)}
+	case latinFirst <= r && r <= latinLast:
+		return n.latin[r-latinFirst]
+	case kanaFirst <= r && r <= kanaLast:
+		return n.kana[r-kanaFirst]
+	case kanaExtFirst <= r && r <= kanaExtLast:
+		return n.kanaExt[r-kanaExtFirst]
+	case widthFirst <= r && r <= widthLast:
+		return n.width[r-widthFirst]
+	case !n.flag.has(KanjiVariantFold | StripIVS | CP932ToJIS | JISToCP932):
+		// The other flags leave the runes out of the compiled tables as
+		// they are.
+		return normEntry{r, vmNone, false}
+	default:
+		// The runes out of the compiled tables, such as the kanji, are
+		// normalized without the tables.
+		r2, m := n.flag.normalizeRune(r)
+		return normEntry{r2, m, false}
+	}
+}
+
 func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
+	e := n.lookup(r)
+	return e.r, e.m
+}
+
+// normalizeRune normalizes the r according to the f without the
+// normalization tables.
+func (f NormFlag) normalizeRune(r rune) (rune, vom) {
+	if f.has(CP932ToJIS | JISToCP932) {
+		if r2, ok := f.mappingVariant(r); ok {
+			return r2, vmNone
+		}
+	}
+
 	c, ok := findUnichar(r)
 	if !ok {
-		return r, vmNone
+		return f.normalizeKanji(r)
 	}
 
 	switch c.category {
@@ -99,16 +203,16 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 
 	case ctLatinLetter:
 		switch {
-		case n.flag.has(AlphaToNarrow):
+		case f.has(AlphaToNarrow):
 			c = c.toNarrowC()
-		case n.flag.has(AlphaToWide):
+		case f.has(AlphaToWide):
 			c = c.toWideC()
 		}
 
 		switch {
-		case n.flag.has(AlphaToUpper):
+		case f.has(AlphaToUpper):
 			return c.toUpperR(), vmNone
-		case n.flag.has(AlphaToLower):
+		case f.has(AlphaToLower):
 			return c.toLowerR(), vmNone
 		default:
 			return c.codepoint, vmNone
@@ -116,9 +220,9 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 
 	case ctLatinDigit:
 		switch {
-		case n.flag.has(DigitToNarrow):
+		case f.has(DigitToNarrow):
 			return c.toNarrowR(), vmNone
-		case n.flag.has(DigitToWide):
+		case f.has(DigitToWide):
 			return c.toWideR(), vmNone
 		default:
 			return c.codepoint, vmNone
@@ -126,33 +230,40 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 
 	case ctLatinSymbol:
 		switch {
-		case n.flag.has(SymbolToNarrow):
+		case f.has(SymbolToNarrow):
 			return c.toNarrowR(), vmNone
-		case n.flag.has(SymbolToWide):
-			return c.toWideR(), vmNone
+		case f.has(SymbolToWide):
+			return f.unifyMapping(c.toWideR()), vmNone
 		default:
 			return c.codepoint, vmNone
 		}
 
 	case ctKanaLetter:
+		c = c.toModernC(f)
+		if f.has(SmallKanaToLarge) {
+			c = c.toLargeC()
+		}
+		if f.has(StripVoicing) {
+			c = c.toUnvoicedC()
+		}
 		var cc *unichar
 		switch c.charCase {
 		case ccHiragana:
 			switch {
-			case n.flag.has(HiraganaToNarrow):
+			case f.has(HiraganaToNarrow):
 				cc = c.toNarrowC()
-			case n.flag.has(HiraganaToKatakana):
+			case f.has(HiraganaToKatakana):
 				cc = c.toKatakanaC()
 			default:
 				cc = c
 			}
 		case ccKatakana:
 			switch {
-			case n.flag.has(KatakanaToNarrow):
+			case f.has(KatakanaToNarrow):
 				cc = c.toNarrowC()
-			case n.flag.has(KatakanaToHiragana):
+			case f.has(KatakanaToHiragana):
 				cc = c.toHiraganaC()
-			case n.flag.has(KatakanaToWide):
+			case f.has(KatakanaToWide):
 				cc = c.toWideC()
 			default:
 				cc = c
@@ -168,9 +279,9 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 
 		case vcVoiced:
 			switch {
-			case n.flag.has(ComposeVom):
+			case f.has(ComposeVom):
 				return cc.composeVoiced()
-			case n.flag.has(DecomposeVom):
+			case f.has(DecomposeVom):
 				return cc.decomposeVoiced()
 			default:
 				return cc.composeVoiced() // fix for TEST_L7tADs2z.
@@ -178,9 +289,9 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 
 		case vcSemivoiced:
 			switch {
-			case n.flag.has(ComposeVom):
+			case f.has(ComposeVom):
 				return cc.composeSemivoiced()
-			case n.flag.has(DecomposeVom):
+			case f.has(DecomposeVom):
 				return cc.decomposeSemivoiced()
 			default:
 				return cc.composeSemivoiced() // fix for TEST_K6t8hQYp
@@ -193,21 +304,28 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 
 	case ctKanaSymbol:
 		switch {
-		case n.flag.has(KanaSymbolToNarrow):
+		case f.has(KanaSymbolToNarrow):
 			return c.toNarrowR(), vmNone
-		case n.flag.has(KanaSymbolToWide):
+		case f.has(KanaSymbolToWide):
 			return c.toWideR(), vmNone
 		default:
 			return c.codepoint, vmNone
 		}
 
+	case ctEnclosed, ctCompat:
+		// The enclosed and compatibility characters are expanded by
+		// nextRule.
+		return c.codepoint, vmNone
+
 	case ctKanaVom:
 		switch {
-		case n.flag.has(IsolatedVomToNarrow):
+		case f.has(StripVoicing):
+			return noRune, vmNone
+		case f.has(IsolatedVomToNarrow):
 			return c.toNarrowR(), vmNone
-		case n.flag.has(IsolatedVomToWide):
+		case f.has(IsolatedVomToWide):
 			return c.toLegacyC().toWideR(), vmNone
-		case n.flag.has(IsolatedVomToNonspace):
+		case f.has(IsolatedVomToNonspace):
 			return c.toCombiningR(), vmNone
 		default:
 			return c.codepoint, vmNone
@@ -225,6 +343,9 @@ func (n *Normalizer) normalizeRune(r rune) (rune, vom) {
 // return a string of length 2.
 func (n *Normalizer) Rune(r rune) string {
 	r1, r2 := n.normalizeRune(r)
+	if r1 == noRune {
+		return ""
+	}
 	if r2.isNone() {
 		return string(r1)
 	}
@@ -232,27 +353,274 @@ func (n *Normalizer) Rune(r rune) string {
 
 }
 
+// mayComposeVom reports whether r may be combined with the voicing
+// modifier that follows it.
+func mayComposeVom(r rune) bool {
+	c, ok := findUnichar(r)
+	if !ok {
+		return false
+	}
+	return c.category == ctKanaLetter &&
+		c.voicing != vcVoiced && c.voicing != vcSemivoiced
+}
+
+// nextNorm normalizes the rune at in[p:], together with the voicing
+// modifier that follows it, and returns the normalized rune, the voicing
+// modifier and the number of bytes consumed from the in.
+// If atEOF is false and the result may depend on bytes that are not
+// in the in yet, nextNorm returns 0 as the number of bytes consumed.
+func (n *Normalizer) nextNorm(in *input, p int, atEOF bool) (rune, vom, int) {
+	if !atEOF && !in.fullRune(p) {
+		return 0, vmNone, 0
+	}
+	r1, size1 := in.decodeRune(p)
+	e := n.lookup(r1)
+	if !e.base {
+		return e.r, e.m, size1
+	}
+	next := p + size1
+	if next >= in.len() || (!atEOF && !in.fullRune(next)) {
+		if !atEOF {
+			return 0, vmNone, 0
+		}
+		return e.r, e.m, size1
+	}
+	r2, size2 := in.decodeRune(next)
+	if !vom(r2).isVom() {
+		return e.r, e.m, size1
+	}
+	if _, ok := n.ruleOut[r2]; ok {
+		// The custom rule for the voicing modifier takes precedence.
+		return e.r, e.m, size1
+	}
+	r, m, ok := n.maybeComposeVom(r1, r2)
+	if ok {
+		return r, m, size1 + size2
+	}
+	return r, m, size1
+}
+
+// next is like nextNorm, but also applies the custom rules and the
+// context-dependent flags, and returns the normalized text, which is
+// either encoded into the buf (which must be large enough for a rune and
+// a voicing modifier) or the replacement of a rule. The caller must not
+// modify the returned bytes.
+func (n *Normalizer) next(buf []byte, in *input, p int, atEOF bool) ([]byte, int) {
+	if n.inContext {
+		return n.nextInContext(buf, in, p, atEOF)
+	}
+	return n.nextRule(buf, in, p, atEOF)
+}
+
+// nextRule is like nextNorm, but also applies the custom rules and
+// replaces a rune with more than one modern letter for the ModernizeKana
+// or with its decomposition for the EnclosedToPlain and CompatToPlain.
+func (n *Normalizer) nextRule(buf []byte, in *input, p int, atEOF bool) ([]byte, int) {
+	if n.ruleOut != nil || n.flag.has(ModernizeKana|EnclosedToPlain|CompatToPlain) {
+		if !atEOF && !in.fullRune(p) {
+			return nil, 0
+		}
+		r, size := in.decodeRune(p)
+		if out, ok := n.ruleOut[r]; ok {
+			return out, size
+		}
+		if out, ok := n.modern(r); ok {
+			return out, size
+		}
+		if out, ok := n.plain(r); ok {
+			return out, size
+		}
+	}
+	r, m, size := n.nextNorm(in, p, atEOF)
+	if size == 0 {
+		return nil, 0
+	}
+	return buf[:encodeNorm(buf, r, m)], size
+}
+
+// encodeNorm writes the UTF-8 encoding of r and m into p (which must be
+// large enough) and returns the number of bytes written. It writes
+// nothing for the noRune.
+func encodeNorm(p []byte, r rune, m vom) int {
+	if r == noRune {
+		return 0
+	}
+	l := utf8.EncodeRune(p, r)
+	if !m.isNone() {
+		l += utf8.EncodeRune(p[l:], rune(m))
+	}
+	return l
+}
+
+// spanNorm returns the length of the longest prefix of the in that the
+// normalization leaves unchanged. short is true if the result may
+// depend on bytes that are not in the in yet.
+func (n *Normalizer) spanNorm(in *input, atEOF bool) (p int, short bool) {
+	var buf [utf8.UTFMax * 2]byte
+	for p < in.len() {
+		out, size := n.next(buf[:], in, p, atEOF)
+		if size == 0 {
+			return p, true
+		}
+		if len(out) != size || !in.equal(p, out) {
+			return p, false
+		}
+		p += size
+	}
+	return p, false
+}
+
+// appendInput normalizes the in[p:], appends the result to the dst and
+// returns the extended buffer and the number of bytes consumed from
+// the in. If atEOF is false, an incomplete rune at the end of the in
+// and a base letter at the end of the in, whose voicing modifier may
+// still follow, are not consumed.
+func (n *Normalizer) appendInput(dst []byte, in *input, p int, atEOF bool) ([]byte, int) {
+	var buf [utf8.UTFMax * 2]byte
+	for p < in.len() {
+		out, size := n.next(buf[:], in, p, atEOF)
+		if size == 0 {
+			break
+		}
+		dst = append(dst, out...)
+		p += size
+	}
+	return dst, p
+}
+
+// appendNorm is like appendInput, but normalizes the whole src in the
+// context ctx, and updates the ctx for the rest of the src.
+func (n *Normalizer) appendNorm(dst, src []byte, atEOF bool, ctx *normCtx) ([]byte, int) {
+	in := inputBytes(src)
+	in.setCtx(*ctx)
+	dst, p := n.appendInput(dst, &in, 0, atEOF)
+	*ctx = in.ctxAt(p)
+	return dst, p
+}
+
+// Bytes normalizes the b according to the current normalization mode.
+// If the b is already normalized, Bytes returns the b itself without
+// allocating.
+func (n *Normalizer) Bytes(b []byte) []byte {
+	in := inputBytes(b)
+	p, _ := n.spanNorm(&in, true)
+	if p == len(b) {
+		return b
+	}
+	out := make([]byte, p, len(b)+len(b)/2)
+	copy(out, b[:p])
+	out, _ = n.appendInput(out, &in, p, true)
+	return out
+}
+
+// AppendBytes appends the normalized form of the b to the dst and
+// returns the extended buffer.
+func (n *Normalizer) AppendBytes(dst, b []byte) []byte {
+	in := inputBytes(b)
+	p, _ := n.spanNorm(&in, true)
+	dst = append(dst, b[:p]...)
+	dst, _ = n.appendInput(dst, &in, p, true)
+	return dst
+}
+
+// AppendString appends the normalized form of the s to the dst and
+// returns the extended buffer.
+func (n *Normalizer) AppendString(dst []byte, s string) []byte {
+	in := inputString(s)
+	p, _ := n.spanNorm(&in, true)
+	dst = append(dst, s[:p]...)
+	dst, _ = n.appendInput(dst, &in, p, true)
+	return dst
+}
+
 // String normalizes the s according to the current normalization mode.
+// If the s is already normalized, String returns the s itself without
+// allocating.
 func (n *Normalizer) String(s string) string {
-	rs := []rune(s)
-	var sb strings.Builder
-	sb.Grow(len(rs) * 2)
-	for i := 0; i < len(rs); i++ {
-		var r1 rune
-		var r2 vom
-		if i < len(rs)-1 {
-			var ok bool
-			r1, r2, ok = n.maybeComposeVom(rs[i], rs[i+1])
-			if ok {
-				i++
+	in := inputString(s)
+	p, _ := n.spanNorm(&in, true)
+	if p == len(s) {
+		return s
+	}
+	out := make([]byte, p, len(s)+len(s)/2)
+	copy(out, s[:p])
+	out, _ = n.appendInput(out, &in, p, true)
+	return string(out)
+}
+
+// Span returns the length of the longest prefix of the s that the
+// normalization leaves unchanged, that is, s[:Span(s)] is normalized and
+// String(s) == s[:Span(s)] + String(s[Span(s):]).
+// A base letter followed by a voicing modifier is treated as a single
+// unit, so the prefix never ends between them. With the context-dependent
+// flags, the prefix also never ends before a rune that the runes
+// preceding it change, such as the [-] of [カ][-][ド].
+func (n *Normalizer) Span(s string) int {
+	if n.inContext {
+		return n.spanInContext(s)
+	}
+	in := inputString(s)
+	p, _ := n.spanNorm(&in, true)
+	return p
+}
+
+// spanInContext is the Span for the context-dependent flags. A prefix
+// s[:b] is the span if it is unchanged and the normalization of the s[b:]
+// from the empty context, which String(s[b:]) starts with, gives the same
+// result as from the context of the s[:b]. To find it in a single pass,
+// a walker that starts at each boundary b with the empty context follows
+// the normalization of the s until the contexts meet, which is usually
+// right after the first unit, or until their results differ.
+func (n *Normalizer) spanInContext(s string) int {
+	type walker struct {
+		start int
+		in    input
+	}
+	var buf, wbuf [utf8.UTFMax * 2]byte
+	var walkers []walker
+	in := inputString(s)
+	span := 0
+	changed := false // whether s[:p] is changed
+	for p := 0; p < len(s) && (!changed || len(walkers) > 0); {
+		if !changed && p > 0 {
+			w := walker{p, inputString(s)}
+			w.in.nextPos = p
+			walkers = append(walkers, w)
+		}
+		out, size := n.nextInContext(buf[:], &in, p, true)
+		if size == 0 {
+			break
+		}
+		if len(out) != size || !in.equal(p, out) {
+			changed = true
+		}
+		alive := walkers[:0]
+		for _, w := range walkers {
+			wout, wsize := n.nextInContext(wbuf[:], &w.in, p, true)
+			switch {
+			case wsize != size || string(wout) != string(out):
+			case w.in.nextCtx == in.nextCtx:
+				// The walkers that start before this one no longer matter.
+				span, alive = w.start, alive[:0]
+			default:
+				alive = append(alive, w)
 			}
-		} else {
-			r1, r2 = n.normalizeRune(rs[i])
 		}
-		sb.WriteRune(r1)
-		if !r2.isNone() {
-			sb.WriteRune(rune(r2))
+		walkers = alive
+		p += size
+		if !changed && p == len(s) {
+			return p
 		}
 	}
-	return sb.String()
+	for _, w := range walkers {
+		// The walkers that reach the end of the s give the same result.
+		span = w.start
+	}
+	return span
+}
+
+// IsNormalized reports whether the s is already normalized according
+// to the current normalization mode, that is, String(s) == s.
+func (n *Normalizer) IsNormalized(s string) bool {
+	return n.Span(s) == len(s)
 }
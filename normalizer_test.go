@@ -911,6 +911,114 @@ var normalizer_stringtests = []Normalizer_StringTest{
 	247: {KanaToWideKatakana, "フリガナ | すす゛き ｲﾁﾛｰ", "フリガナ | スズキ イチロー"},
 	248: {KanaToNarrowKatakana, "ﾌﾘｶﾞﾅ | スズキ いちろう", "ﾌﾘｶﾞﾅ | ｽｽﾞｷ ｲﾁﾛｳ"},
 	249: {LatinToNarrow | AlphaToUpper, "ローマ字(半角) | Ｓｕｚｕｋｉ, ichiro", "ローマ字(半角) | SUZUKI, ICHIRO"},
+
+	// small kana to large
+	250: {SmallKanaToLarge, "", ""},
+	251: {SmallKanaToLarge, "ぁぃぅぇぉっゃゅょゎゕゖ", "あいうえおつやゆよわかけ"},
+	252: {SmallKanaToLarge, "ァィゥェォッャュョヮヵヶ", "アイウエオツヤユヨワカケ"},
+	253: {SmallKanaToLarge, "ㇰㇱㇲㇳㇴㇵㇶㇷㇸㇹㇺㇻㇼㇽㇾㇿ", "クシストヌハヒフヘホムラリルレロ"},
+	254: {SmallKanaToLarge, "ｧｨｩｪｫｯｬｭｮ", "ｱｲｳｴｵﾂﾔﾕﾖ"},
+	255: {SmallKanaToLarge, "キャッシュ", "キヤツシユ"},
+	256: {SmallKanaToLarge, "ｷｬｯｼｭ", "ｷﾔﾂｼﾕ"},
+	257: {SmallKanaToLarge, "ゔぁ", "ゔあ"},
+	258: {SmallKanaToLarge | Fold, "ｷｬｯｼｭ", "キヤツシユ"},
+	259: {SmallKanaToLarge | KanaToHiragana, "キャッㇰ", "きやつく"},
+	260: {SmallKanaToLarge | KanaToNarrow, "キャッシュ", "ｷﾔﾂｼﾕ"},
+	261: {SmallKanaToLarge | KanaToWideKatakana, "ぁゕ", "アカ"},
+	262: {SmallKanaToLarge | AlphaToUpper, "aぁ", "Aあ"},
+	263: {Fold, "ｷｬｯｼｭ", "キャッシュ"},
+
+	// stripping the voicing
+	264: {StripVoicing, "", ""},
+	265: {StripVoicing, "がぎぐげごぱぴぷぺぽゔ", "かきくけこはひふへほう"},
+	266: {StripVoicing, "ガパヴヷヸヹヺ", "カハウワヰヱヲ"},
+	267: {StripVoicing, "ｶﾞﾊﾟｳﾞ", "ｶﾊｳ"},
+	268: {StripVoicing, "か゛は゜か\u3099は\u309A", "かはかは"},
+	269: {StripVoicing, "が゛", "か"},
+	270: {StripVoicing, "゛゜ﾞﾟ\u3099\u309A", ""},
+	271: {StripVoicing, "a゛漢ﾞ", "a漢"},
+	272: {StripVoicing | Fold, "ｶﾞｯﾊﾟ", "カッハ"},
+	273: {StripVoicing | KanaToHiragana, "ガｳﾞ", "かう"},
+	274: {StripVoicing | KanaToNarrow, "ガパ゛", "ｶﾊ"},
+	275: {StripVoicing | SmallKanaToLarge, "ガッ", "カツ"},
+	276: {StripVoicing | ComposeVom, "か゛", "か"},
+
+	// modernizing the kana
+	277: {ModernizeKana, "", ""},
+	278: {ModernizeKana, "ゐゑヰヱ", "いえイエ"},
+	279: {ModernizeKana, "ヷヸヹヺ", "ヴァヴィヴェヴォ"},
+	280: {ModernizeKana, "ㇰㇱㇲㇳㇴㇵㇶㇷㇸㇹㇺㇻㇼㇽㇾㇿ", "クシストヌハヒフヘホムラリルレロ"},
+	281: {ModernizeKana, "ゟヿ", "よりコト"},
+	282: {ModernizeKana, "ヴァ", "ヴァ"},
+	283: {ModernizeKana | ModernizeVaToBa, "ヷヸヹヺゐ", "バビベボい"},
+	284: {ModernizeVaToBa, "ヷヸヹヺゐ", "バビベボゐ"},
+	285: {ModernizeKana | KanaToHiragana, "ヰヷヿ", "いゔぁこと"},
+	286: {ModernizeKana | KanaToNarrow, "ヰヷヿ", "ｲｳﾞｧｺﾄ"},
+	287: {ModernizeKana | DecomposeVom, "ヷ", "ウ\u3099ァ"},
+	288: {ModernizeKana | SmallKanaToLarge, "ヷ", "ヴア"},
+	289: {ModernizeKana | ModernizeVaToBa | StripVoicing, "ヷ", "ハ"},
+	290: {ModernizeKana | Fold, "ゐ゛ｱ", "い゛ア"},
+	291: {Fold, "ゐヷゟ", "ゐヷゟ"},
+
+	// enclosed characters to plain
+	292: {EnclosedToPlain, "", ""},
+	293: {EnclosedToPlain, "①②③⑳", "12320"},
+	294: {EnclosedToPlain, "⑴⒇⒈⒛", "(1)(20)1.20."},
+	295: {EnclosedToPlain, "㈱㈲㊤㊥㊦", "(株)(有)上中下"},
+	296: {EnclosedToPlain, "ⒶⓐⓏ⒜", "AaZ(a)"},
+	297: {EnclosedToPlain, "㋐㋾㉑㋿", "アヲ21令和"},
+	298: {EnclosedToPlain, "⓫⓿", "⓫⓿"},
+	299: {EnclosedToPlain | LatinToWide, "①⑴Ⓐ", "１（１）Ａ"},
+	300: {EnclosedToPlain | AlphaToUpper, "ⓐ⒜", "A(A)"},
+	301: {EnclosedToPlain | KanaToNarrow, "㋐㋕", "ｱｶ"},
+	302: {EnclosedToPlain | Fold, "㈱ｱ①", "(株)ア1"},
+	303: {Fold, "①⑴㈱Ⓐ", "①⑴㈱Ⓐ"},
+
+	// CJK compatibility characters to plain
+	304: {CompatToPlain, "", ""},
+	305: {CompatToPlain, "㌔㍍㌀", "キロメートルアパート"},
+	306: {CompatToPlain, "㍻㍼㍽㍾㍿", "平成昭和大正明治株式会社"},
+	307: {CompatToPlain, "㎏㎝㏄℡", "kgcmccTEL"},
+	308: {CompatToPlain, "㏠㍘№℃", "1日0点No°C"},
+	309: {CompatToPlain, "Ω℞", "Ω℞"},
+	310: {CompatToPlain | Fold, "㌔ｶﾞ㎏Ａ", "キロガkgA"},
+	311: {CompatToPlain | KanaToNarrow, "㌔㍍㌀", "ｷﾛﾒｰﾄﾙｱﾊﾟｰﾄ"},
+	312: {CompatToPlain | KanaToHiragana, "㌔㌀", "きろあぱーと"},
+	313: {CompatToPlain | LatinToWide | AlphaToUpper, "㎏℡", "ＫＧＴＥＬ"},
+	314: {CompatToPlain | DecomposeVom, "㌀", "アハ\u309Aート"},
+	315: {CompatToPlain, "①㈱", "①㈱"},
+	316: {CompatToPlain | EnclosedToPlain, "①㌔", "1キロ"},
+	317: {Fold, "㌔㎏℡", "㌔㎏℡"},
+
+	// kanji variants and the ideographic variation selectors
+	318: {KanjiVariantFold, "", ""},
+	319: {KanjiVariantFold, "髙橋德川﨑山", "高橋徳川崎山"},
+	320: {KanjiVariantFold, "\uF9DC\uFA12\uF92D", "隆晴来"},
+	321: {KanjiVariantFold, "渡邊渡邉𠮷田", "渡辺渡辺吉田"},
+	322: {KanjiVariantFold, "高崎徳", "高崎徳"},
+	323: {KanjiVariantFold, "葛\U000E0100", "葛\U000E0100"},
+	324: {KanjiVariantFold | StripIVS, "葛\U000E0100城辻\U000E01EF", "葛城辻"},
+	325: {StripIVS, "髙\U000E0101", "髙"},
+	326: {KanjiVariantFold | Fold, "ｶﾞ髙Ａ", "ガ高A"},
+	327: {KanjiVariantFold | ExpandIterationMark, "髙々", "高高"},
+	328: {Fold, "髙﨑\U000E0100", "髙﨑\U000E0100"},
+
+	// CP932 and JIS X 0208 symbols
+	329: {CP932ToJIS, "", ""},
+	330: {CP932ToJIS, "～－∥￠￡￢", "〜−‖¢£¬"},
+	331: {CP932ToJIS, "〜−‖¢£¬", "〜−‖¢£¬"},
+	332: {JISToCP932, "〜−‖¢£¬", "～－∥￠￡￢"},
+	333: {JISToCP932, "～－∥￠￡￢", "～－∥￠￡￢"},
+	334: {CP932ToJIS | SymbolToNarrow, "～－＋", "〜−+"},
+	335: {CP932ToJIS | SymbolToWide, "~+¢", "〜＋¢"},
+	336: {JISToCP932 | SymbolToNarrow, "〜−～－＋", "～－～－+"},
+	337: {JISToCP932 | SymbolToWide, "~-+", "～－＋"},
+	338: {CP932ToJIS | Fold, "１０～２０ｱ", "10〜20ア"},
+	339: {JISToCP932 | KanaToNarrow, "〜ア", "～ｱ"},
+	340: {SymbolToNarrow, "～－￠", "~-￠"},
+	341: {Fold, "〜−‖¢", "〜−‖¢"},
+	342: {CP932ToJIS | SymbolToWide, "-", "－"},
+	343: {JISToCP932 | SymbolToWide, "-", "－"},
 }
 
 func hexs(s string) string {
@@ -947,6 +1055,170 @@ func TestNormalizer_String(t *testing.T) {
 	}
 }
 
+func TestNormalizer_Bytes(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out := n.Bytes([]byte(tt.in))
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, Bytes(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestNormalizer_Append(t *testing.T) {
+	const prefix = "ｶﾞ"
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out := n.AppendString([]byte(prefix), tt.in)
+		if string(out) != prefix+tt.out {
+			t.Errorf("#%d %s, AppendString(%q, %q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, prefix, tt.in, out, prefix+tt.out)
+		}
+		out = n.AppendBytes([]byte(prefix), []byte(tt.in))
+		if string(out) != prefix+tt.out {
+			t.Errorf("#%d %s, AppendBytes(%q, %q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, prefix, tt.in, out, prefix+tt.out)
+		}
+	}
+}
+
+func TestNormalizer_NoAlloc(t *testing.T) {
+	n, err := Norm(Fold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := "Go言語のパッケージ (Package)"
+	b := []byte(s)
+	dst := make([]byte, 0, len(s))
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"String", func() { n.String(s) }},
+		{"Bytes", func() { n.Bytes(b) }},
+		{"AppendString", func() { n.AppendString(dst, s) }},
+		{"AppendBytes", func() { n.AppendBytes(dst, b) }},
+	}
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(10, tt.f); allocs > 0 {
+			t.Errorf("%s(%q) allocates %v times, want 0", tt.name, s, allocs)
+		}
+	}
+	if out := n.Bytes(b); &out[0] != &b[0] {
+		t.Errorf("Bytes(%q) returned a copy, want the input itself", s)
+	}
+}
+
+func TestNormalizer_compile(t *testing.T) {
+	flags := make([]NormFlag, 0, len(normflagMap)+len(combflagList))
+	for flag := range normflagMap {
+		flags = append(flags, flag)
+	}
+	for _, combflag := range combflagList {
+		flags = append(flags, combflag.flag)
+	}
+	for _, flag := range flags {
+		n, err := Norm(flag)
+		if err != nil {
+			t.Errorf("%s: %s", flag, err.Error())
+			continue
+		}
+		for r := rune(0); r <= 0xFFFF; r++ {
+			have, haveVM := n.normalizeRune(r)
+			want, wantVM := flag.normalizeRune(r)
+			if have != want || haveVM != wantVM {
+				t.Errorf("%s, normalizeRune(%#U)\n\thave: (%#U, %#U)\n\twant: (%#U, %#U)",
+					flag, r, have, haveVM, want, wantVM)
+				break
+			}
+		}
+	}
+}
+
+func TestNormalizer_zero(t *testing.T) {
+	var n Normalizer
+	in := "ＡａｱｶﾞがAa"
+	if out := n.String(in); out != in {
+		t.Errorf("String(%q) = %q, want: %q", in, out, in)
+	}
+}
+
+type Normalizer_SpanTest struct {
+	flag NormFlag
+	in   string
+	n    int
+}
+
+var normalizer_spantests = []Normalizer_SpanTest{
+	0:  {Fold, "", 0},
+	1:  {Fold, "abc", 3},
+	2:  {Fold, "abcアイウ", 12},
+	3:  {Fold, "abcｱｲｳ", 3},
+	4:  {Fold, "abcか", 6},
+	5:  {Fold, "abcか゛", 3},
+	6:  {Fold, "abcか\u3099", 3},
+	7:  {Fold, "abcが", 6},
+	8:  {Fold, "abcが゛", 9},
+	9:  {Fold, "abcｶ", 3},
+	10: {KanaToNarrow, "ｶﾞ", 6},
+	11: {KanaToNarrow, "ｶﾞｶﾞか", 12},
+	12: {DecomposeVom, "か\u3099", 6},
+	13: {DecomposeVom, "が", 0},
+	14: {Fold, "ＡBC", 0},
+	15: {Fold, "ABC\xff", 3},
+	16: {Fold | ProlongedSoundMarkByContext, "カ-ド", 0},
+	17: {Fold | ProlongedSoundMarkByContext, "カード-", 6},
+	18: {ExpandIterationMark, "ここゝ", 3},
+	19: {CollapseIterationMark, "あここ", 3},
+	20: {ExpandIterationMark, "か\u3099ゝ", 0},
+}
+
+func TestNormalizer_Span(t *testing.T) {
+	for i, tt := range normalizer_spantests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		have := n.Span(tt.in)
+		if have != tt.n {
+			t.Errorf("#%d %s, Span(%q) = %d, want: %d", i, tt.flag, tt.in, have, tt.n)
+			continue
+		}
+		if want := tt.in[:have] + n.String(tt.in[have:]); n.String(tt.in) != want {
+			t.Errorf("#%d %s, String(%q) = %q, want: %q", i, tt.flag, tt.in, n.String(tt.in), want)
+		}
+		if have, want := n.IsNormalized(tt.in), tt.n == len(tt.in); have != want {
+			t.Errorf("#%d %s, IsNormalized(%q) = %v, want: %v", i, tt.flag, tt.in, have, want)
+		}
+	}
+}
+
+func TestNormalizer_IsNormalized(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if have, want := n.IsNormalized(tt.in), tt.in == tt.out; have != want {
+			t.Errorf("#%d %s, IsNormalized(%q) = %v, want: %v", i, tt.flag, tt.in, have, want)
+		}
+		if have, want := n.IsNormalized(tt.out), n.String(tt.out) == tt.out; have != want {
+			t.Errorf("#%d %s, IsNormalized(%q) = %v, want: %v", i, tt.flag, tt.out, have, want)
+		}
+	}
+}
+
 type NormTest struct {
 	flag NormFlag
 	errS string
@@ -955,6 +1227,10 @@ type NormTest struct {
 var normtests = []NormTest{
 	{KatakanaToWide | KatakanaToNarrow, "invalid normalization flag"},
 	{KatakanaToWide, ""},
+	{StripVoicing | DecomposeVom, "invalid normalization flag"},
+	{StripVoicing, ""},
+	{CP932ToJIS | JISToCP932, "invalid normalization flag"},
+	{CP932ToJIS, ""},
 }
 
 func TestNorm(t *testing.T) {
@@ -1001,22 +1277,40 @@ func TestNormalizer_SetFlag(t *testing.T) {
 	}
 }
 
-func normflags() []int {
-	flags := make([]int, 0, len(normflagMap))
+// normflags returns the flags of which all the combinations are tested.
+// The flags from ProlongedSoundMarkByContext on are tested by
+// TestHeavyExtraNormFlags, so as not to double the combinations for every
+// new flag.
+func normflags() []NormFlag {
+	flags := make([]NormFlag, 0, len(normflagMap))
+	for key := range normflagMap {
+		if key >= ProlongedSoundMarkByContext {
+			continue
+		}
+		flags = append(flags, key)
+	}
+	return flags
+}
+
+// extranormflags returns the flags from ProlongedSoundMarkByContext on.
+func extranormflags() []NormFlag {
+	flags := make([]NormFlag, 0, len(normflagMap))
 	for key := range normflagMap {
-		flags = append(flags, int(key))
+		if key >= ProlongedSoundMarkByContext {
+			flags = append(flags, key)
+		}
 	}
 	return flags
 }
 
 // nCm combinations algorithm
-func comb(arr []int, n int) (result [][]int) {
+func comb(arr []NormFlag, n int) (result [][]NormFlag) {
 	if n <= 0 || n > len(arr) {
 		return result
 	}
 	if n == 1 {
 		for _, e := range arr {
-			result = append(result, []int{e})
+			result = append(result, []NormFlag{e})
 		}
 		return result
 	}
@@ -1024,13 +1318,13 @@ func comb(arr []int, n int) (result [][]int) {
 		return append(result, arr)
 	}
 	for _, a := range comb(arr[1:], n-1) {
-		c := append([]int{arr[0]}, a...)
+		c := append([]NormFlag{arr[0]}, a...)
 		result = append(result, c)
 	}
 	return append(result, comb(arr[1:], n)...)
 }
 
-func comball(arr []int) (result [][]int) {
+func comball(arr []NormFlag) (result [][]NormFlag) {
 	for i := 1; i <= len(arr); i++ {
 		c := comb(arr, i)
 		log.Printf("  %02dC%02d = %7d\n", len(arr), i, len(c))
@@ -1045,7 +1339,7 @@ func normflagcombs() []NormFlag {
 	result := make([]NormFlag, len(flagcombs))
 	for i, comb := range flagcombs {
 		for _, flag := range comb {
-			result[i] |= NormFlag(flag)
+			result[i] |= flag
 		}
 	}
 	return result
@@ -1069,6 +1363,127 @@ outer:
 	return valid, invalid
 }
 
+// checkNormalizeRunes checks the normalizeRune of the n created by Norm
+// with the flag for the runes from the lo up to the hi (exclusive), and
+// reports whether it passes.
+func checkNormalizeRunes(t *testing.T, n *Normalizer, flag NormFlag, lo, hi rune) bool {
+	for r := lo; r < hi; r++ {
+		c, rOK := findUnichar(r)
+		nr, vm := n.normalizeRune(r)
+		nrOK := rOK
+		if nr != r {
+			_, nrOK = findUnichar(nr)
+		}
+		if rOK != nrOK {
+			// TEST_G9amUMTr
+			if rOK {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: %#U is not exists in unichars"+
+					"\n\twant: %#U is exists in unichars", r, flag, nr, nr)
+			} else {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: %#U is exists in unichars"+
+					"\n\twant: %#U is not exists in unichars", r, flag, nr, nr)
+			}
+			return false
+		}
+		if !nrOK {
+			if vm != vmNone {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+					r, flag, nr, vm, vmNone)
+				return false
+			}
+			if r != nr {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: nr=%#U",
+					r, flag, nr, vm, r)
+				return false
+			}
+			continue
+		}
+		if c.voicing == vcUndefined || c.voicing == vcUnvoiced {
+			if vm != vmNone {
+				// TEST_nD7FwQUW
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+					r, flag, nr, vm, vmNone)
+				return false
+			}
+			continue
+		}
+		if (c.charCase == ccHiragana && flag.has(HiraganaToNarrow) && !flag.has(DecomposeVom)) ||
+			(c.charCase == ccKatakana && flag.has(KatakanaToNarrow) && !flag.has(DecomposeVom)) {
+			if c.voicing == vcVoiced && vm != vmVsmNarrow {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+					r, flag, nr, vm, vmVsmNarrow)
+				return false
+			}
+			if c.voicing == vcSemivoiced && vm != vmSsmNarrow {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+					r, flag, nr, vm, vmSsmNarrow)
+				return false
+			}
+		}
+		if c.charCase == ccHiragana && flag.has(HiraganaToKatakana) && !flag.has(DecomposeVom) {
+			if vm != vmNone {
+				t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+					r, flag, nr, vm, vmNone)
+				return false
+			}
+		}
+		if c.charCase == ccKatakana && flag.has(KatakanaToHiragana) && !flag.has(DecomposeVom) {
+			switch r {
+			case 'ヷ', 'ヸ', 'ヹ', 'ヺ':
+				if vm != vmVsmWide {
+					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+						r, flag, nr, vm, vmVsmWide)
+					return false
+				}
+			default:
+				if vm != vmNone {
+					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
+						r, flag, nr, vm, vmNone)
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// comparedRunes are the runes of the compiled tables, the kanji variants
+// and the mappings of CP932 and JIS, for which the normalizeRune of a
+// Normalizer is compared with the one without the tables.
+var comparedRunes = func() []rune {
+	var runes []rune
+	for _, rng := range [][2]rune{
+		{latinFirst, latinLast}, {kanaFirst, kanaLast},
+		{kanaExtFirst, kanaExtLast}, {widthFirst, widthLast},
+	} {
+		for r := rng[0]; r <= rng[1]; r++ {
+			runes = append(runes, r)
+		}
+	}
+	for r := range kanjiVariantTable {
+		runes = append(runes, r)
+	}
+	for r, r2 := range cp932ToJISList {
+		runes = append(runes, r, r2)
+	}
+	return append(runes, 0, '漢', 0xE0100, 0x1F600, maxr)
+}()
+
+// checkCompiled checks the normalizeRune of the n created by Norm with
+// the flag against the flag.normalizeRune, which does not use the
+// compiled tables, for the comparedRunes, and reports whether it passes.
+func checkCompiled(t *testing.T, n *Normalizer, flag NormFlag) bool {
+	for _, r := range comparedRunes {
+		nr, vm := n.normalizeRune(r)
+		if wr, wvm := flag.normalizeRune(r); nr != wr || vm != wvm {
+			t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U"+
+				"\n\twant: nr=%#U, vm=%#U (not compiled)", r, flag, nr, vm, wr, wvm)
+			return false
+		}
+	}
+	return true
+}
+
 func TestHeavyNormFlags(t *testing.T) {
 	valid, invalid := parenormflagcombs()
 	log.Printf("  valid : %7d\n", len(valid))
@@ -1089,79 +1504,8 @@ outer:
 			t.Errorf("TestInvalidFlags: %s is invalid, want: valid", flag)
 			continue
 		}
-		for r := rune(0); r < maxr; r++ {
-			c, rOK := findUnichar(r)
-			nr, vm := n.normalizeRune(r)
-			_, nrOK := findUnichar(nr)
-			if rOK != nrOK {
-				// TEST_G9amUMTr
-				if rOK {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: %#U is not exists in unichars"+
-						"\n\twant: %#U is exists in unichars", r, flag, nr, nr)
-				} else {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: %#U is exists in unichars"+
-						"\n\twant: %#U is not exists in unichars", r, flag, nr, nr)
-				}
-				break outer
-			}
-			if !nrOK {
-				if vm != vmNone {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-						r, flag, nr, vm, vmNone)
-					break outer
-				}
-				if r != nr {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: nr=%#U",
-						r, flag, nr, vm, r)
-					break outer
-				}
-				continue
-			}
-			if c.voicing == vcUndefined || c.voicing == vcUnvoiced {
-				if vm != vmNone {
-					// TEST_nD7FwQUW
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-						r, flag, nr, vm, vmNone)
-					break outer
-				}
-				continue
-			}
-			if (c.charCase == ccHiragana && flag.has(HiraganaToNarrow) && !flag.has(DecomposeVom)) ||
-				(c.charCase == ccKatakana && flag.has(KatakanaToNarrow) && !flag.has(DecomposeVom)) {
-				if c.voicing == vcVoiced && vm != vmVsmNarrow {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-						r, flag, nr, vm, vmVsmNarrow)
-					break outer
-				}
-				if c.voicing == vcSemivoiced && vm != vmSsmNarrow {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-						r, flag, nr, vm, vmSsmNarrow)
-					break outer
-				}
-			}
-			if c.charCase == ccHiragana && flag.has(HiraganaToKatakana) && !flag.has(DecomposeVom) {
-				if vm != vmNone {
-					t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-						r, flag, nr, vm, vmNone)
-					break outer
-				}
-			}
-			if c.charCase == ccKatakana && flag.has(KatakanaToHiragana) && !flag.has(DecomposeVom) {
-				switch r {
-				case 'ヷ', 'ヸ', 'ヹ', 'ヺ':
-					if vm != vmVsmWide {
-						t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-							r, flag, nr, vm, vmVsmWide)
-						break outer
-					}
-				default:
-					if vm != vmNone {
-						t.Errorf("normalizeRune(%#U), flags: %s,\n\thave: nr=%#U, vm=%#U\n\twant: vm=%#U",
-							r, flag, nr, vm, vmNone)
-						break outer
-					}
-				}
-			}
+		if !checkNormalizeRunes(t, n, flag, 0, maxr) || !checkCompiled(t, n, flag) {
+			break outer
 		}
 		if i%500 == 0 {
 			log.Printf("%5d/%5d (%3d%% done)", i, len(valid), i*100/len(valid))
@@ -1169,6 +1513,65 @@ outer:
 	}
 }
 
+// extraSTR has the characters that the flags from
+// ProlongedSoundMarkByContext on change.
+const extraSTR = "ｶｰﾄﾞ-ー―Ａ-1ぁゕｷｬｯｼｭがｶﾞか゛こゝゞヽ々ここゐヷゟ①⑴㈱Ⓐ㌔㎏℡髙﨑葛\U000E0100～－−〜"
+
+// TestHeavyExtraNormFlags tests all the combinations of the flags from
+// ProlongedSoundMarkByContext on, combined with nothing and with each of
+// the combination flags such as Fold, and each of them combined with each
+// of the other flags.
+func TestHeavyExtraNormFlags(t *testing.T) {
+	bases := []NormFlag{0}
+	for _, c := range combflagList {
+		bases = append(bases, c.flag)
+	}
+	var flags []NormFlag
+	for _, comb := range comball(extranormflags()) {
+		var extra NormFlag
+		for _, f := range comb {
+			extra |= f
+		}
+		for _, base := range bases {
+			flags = append(flags, extra|base)
+		}
+	}
+	for _, extra := range extranormflags() {
+		for _, f := range normflags() {
+			flags = append(flags, extra|f)
+		}
+	}
+	log.Printf("  total : %7d\n", len(flags))
+	for _, flag := range flags {
+		invalid := false
+		for _, invalidFlags := range invalidFlagsList {
+			if flag&invalidFlags == invalidFlags {
+				invalid = true
+				break
+			}
+		}
+		n, err := Norm(flag)
+		if invalid {
+			if err == nil {
+				t.Errorf("%s is valid, want: invalid", flag)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s is invalid, want: valid", flag)
+			continue
+		}
+		if !checkCompiled(t, n, flag) {
+			return
+		}
+		u := uncompiled(flag)
+		if have, want := n.String(extraSTR), u.String(extraSTR); have != want {
+			t.Errorf("%s, String(%q)\n\thave: %q\n\twant: %q (not compiled)", flag, extraSTR, have, want)
+			return
+		}
+	}
+}
+
 const normSTR = "Aa#　Ａａ＃あア。ｱ｡”ﾞ漢字ｶﾞｷﾞｸﾞｹﾞｺﾞﾊﾟﾋﾟﾌﾟﾍﾟﾎﾟ"
 
 func BenchmarkString(b *testing.B) {
@@ -1180,6 +1583,90 @@ func BenchmarkString(b *testing.B) {
 	b.StopTimer()
 }
 
+func BenchmarkNormalizeRune(b *testing.B) {
+	n, _ := Norm(LatinToNarrow | KanaToWide)
+	rs := []rune(normSTR)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range rs {
+			n.normalizeRune(r)
+		}
+	}
+	b.StopTimer()
+}
+
+func BenchmarkNormalizeRuneNotCompiled(b *testing.B) {
+	flag := LatinToNarrow | KanaToWide
+	rs := []rune(normSTR)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range rs {
+			flag.normalizeRune(r)
+		}
+	}
+	b.StopTimer()
+}
+
+func BenchmarkBytes(b *testing.B) {
+	n, _ := Norm(LatinToNarrow | KanaToWide)
+	in := []byte(normSTR)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.Bytes(in)
+	}
+	b.StopTimer()
+}
+
+func BenchmarkStringNotCompiled(b *testing.B) {
+	n := uncompiled(LatinToNarrow | KanaToWide)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.String(normSTR)
+	}
+	b.StopTimer()
+}
+
+func BenchmarkBytesNotCompiled(b *testing.B) {
+	n := uncompiled(LatinToNarrow | KanaToWide)
+	in := []byte(normSTR)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.Bytes(in)
+	}
+	b.StopTimer()
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	n, _ := Norm(LatinToNarrow | KanaToWide)
+	buf := make([]byte, 0, len(normSTR)*2)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.AppendString(buf[:0], normSTR)
+	}
+	b.StopTimer()
+}
+
+func BenchmarkAppendBytes(b *testing.B) {
+	n, _ := Norm(LatinToNarrow | KanaToWide)
+	in := []byte(normSTR)
+	buf := make([]byte, 0, len(normSTR)*2)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.AppendBytes(buf[:0], in)
+	}
+	b.StopTimer()
+}
+
+func BenchmarkStringNormalized(b *testing.B) {
+	n, _ := Norm(LatinToNarrow | KanaToWide)
+	s := n.String(normSTR)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n.String(s)
+	}
+	b.StopTimer()
+}
+
 func BenchmarkNormNFKD(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
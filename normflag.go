@@ -6,7 +6,12 @@ import (
 )
 
 // NormFlag is the normalization rule used by Normalizer.
-type NormFlag int
+//
+// NormFlag is a uint64 rather than an int, since there are more flags
+// than the bits of an int on the 32-bit platforms. The flags are meant to
+// be combined by the | operator, so the code that converts a NormFlag to
+// or from an int must use uint64 instead.
+type NormFlag uint64
 
 // Constants to identify various normalization flags.
 const (
@@ -128,6 +133,107 @@ const (
 	//  [゛] => [\u3099],  [ﾞ] => [\u3099],  [゜] = [\u309A],  [ﾟ] => [\u309A]
 	IsolatedVomToNonspace
 
+	// ProlongedSoundMarkByContext converts a dash-like character into
+	// a prolonged sound mark after a kana letter, or into a hyphen-minus
+	// between Latin letters or digits, according to its neighbours.
+	// The width follows the letter that precedes it.
+	// Examples:
+	//  [カ][－][ド] => [カ][ー][ド],  [ｶ][-][ﾄ][ﾞ] => [ｶ][ｰ][ﾄ][ﾞ],
+	//  [0][3][ー][1] => [0][3][-][1],  [０][３][ー][１] => [０][３][－][１]
+	ProlongedSoundMarkByContext
+
+	// SmallKanaToLarge converts the small Hiragana-Katakana letters
+	// to their large ones.
+	// Examples: [ッ] => [ツ],  [ぁ] => [あ],  [ｯ] => [ﾂ],  [ㇰ] => [ク]
+	SmallKanaToLarge
+
+	// StripVoicing converts the voiced or semi-voiced sound letters to
+	// their unvoiced ones, and removes the voicing modifiers, which
+	// takes precedence over the IsolatedVomTo flags.
+	// Examples:
+	//  [が] => [か],  [パ] => [ハ],  [ｶ][ﾞ] => [ｶ],  [か][゛] => [か],
+	//  [゛] => [],    [\u3099] => []
+	StripVoicing
+
+	// ExpandIterationMark replaces an iteration mark with the letter
+	// that it repeats. The [ゝ] and [ヽ] repeat the unvoiced letter, and
+	// the [ゞ] and [ヾ] repeat the voiced one.
+	// Examples:
+	//  [い][す][ゞ] => [い][す][ず],  [カ][ヾ] => [カ][ガ],
+	//  [こ][ゝ][ろ] => [こ][こ][ろ],  [時][々] => [時][時]
+	ExpandIterationMark
+
+	// CollapseIterationMark replaces a letter that repeats the preceding
+	// one with an iteration mark, which is the reverse of the
+	// ExpandIterationMark.
+	// Examples:
+	//  [い][す][ず] => [い][す][ゞ],  [カ][ガ] => [カ][ヾ],
+	//  [こ][こ][ろ] => [こ][ゝ][ろ],  [時][時] => [時][々]
+	CollapseIterationMark
+
+	// ModernizeKana converts the historical or obsolete kana letters to
+	// their modern ones. The letters [ヷ][ヸ][ヹ][ヺ] are converted to
+	// [ヴ][ァ] etc. unless the ModernizeVaToBa is also specified.
+	// Examples:
+	//  [ゐ] => [い],     [ヱ] => [エ],     [ヷ] => [ヴ][ァ],  [ヺ] => [ヴ][ォ],
+	//  [ㇰ] => [ク],     [ㇷ] => [フ],     [ゟ] => [よ][り],  [ヿ] => [コ][ト]
+	ModernizeKana
+
+	// ModernizeVaToBa converts the letters [ヷ][ヸ][ヹ][ヺ] to
+	// [バ][ビ][ベ][ボ].
+	// Examples:
+	//  [ヷ] => [バ],  [ヸ] => [ビ],  [ヹ] => [ベ],  [ヺ] => [ボ]
+	ModernizeVaToBa
+
+	// EnclosedToPlain expands the enclosed or parenthesized characters
+	// to their plain characters, which are then normalized according to
+	// the other flags, such as the LatinToNarrow.
+	// Examples:
+	//  [①] => [1],  [⑴] => [(][1][)],  [⒈] => [1][.],
+	//  [㈱] => [(][株][)],  [㊤] => [上],  [Ⓐ] => [A]
+	EnclosedToPlain
+
+	// CompatToPlain expands the CJK compatibility characters, such as
+	// the squared words, units and era names, and the letterlike symbols
+	// to their plain spelling, which is then normalized according to the
+	// other flags, such as the Fold.
+	// Examples:
+	//  [㌔] => [キ][ロ],  [㍍] => [メ][ー][ト][ル],  [㍻] => [平][成],
+	//  [㍿] => [株][式][会][社],  [㎏] => [k][g],  [℡] => [T][E][L]
+	CompatToPlain
+
+	// KanjiVariantFold converts the CJK compatibility ideographs to
+	// their unified ideographs, and the variant kanji (itaiji) commonly
+	// used in names to their standard kanji.
+	// Examples:
+	//  [髙] => [高],  [﨑] => [崎],  [德] => [徳],  [\uF9DC] => [隆]
+	KanjiVariantFold
+
+	// StripIVS removes the ideographic variation selectors
+	// (U+E0100-U+E01EF), which is usually specified together with the
+	// KanjiVariantFold.
+	// Examples:
+	//  [葛][\U000E0100] => [葛],  [辻][\U000E0101] => [辻]
+	StripIVS
+
+	// CP932ToJIS converts the characters that Microsoft's mapping of
+	// Shift_JIS (CP932) gives to those that the JIS mapping gives for
+	// the same codes. These characters are not converted by the width
+	// flags, but the SymbolToWide gives the JIS ones, except that [-] is
+	// widened to [－].
+	// Examples:
+	//  [～] => [〜],  [－] => [−],  [∥] => [‖],
+	//  [￠] => [¢],   [￡] => [£],  [￢] => [¬],  [~] => [〜] (with SymbolToWide)
+	CP932ToJIS
+
+	// JISToCP932 converts the characters that the JIS mapping of
+	// Shift_JIS gives to those that Microsoft's mapping (CP932) gives for
+	// the same codes, which is the reverse of the CP932ToJIS.
+	// Examples:
+	//  [〜] => [～],  [−] => [－],  [‖] => [∥],
+	//  [¢] => [￠],   [£] => [￡],  [¬] => [￢]
+	JISToCP932
+
 	normflagMax
 )
 
@@ -285,6 +391,20 @@ var normflagMap = map[NormFlag]string{
 	IsolatedVomToNarrow:   "IsolatedVomToNarrow",
 	IsolatedVomToWide:     "IsolatedVomToWide",
 	IsolatedVomToNonspace: "IsolatedVomToNonspace",
+
+	ProlongedSoundMarkByContext: "ProlongedSoundMarkByContext",
+	SmallKanaToLarge:            "SmallKanaToLarge",
+	StripVoicing:                "StripVoicing",
+	ExpandIterationMark:         "ExpandIterationMark",
+	CollapseIterationMark:       "CollapseIterationMark",
+	ModernizeKana:               "ModernizeKana",
+	ModernizeVaToBa:             "ModernizeVaToBa",
+	EnclosedToPlain:             "EnclosedToPlain",
+	CompatToPlain:               "CompatToPlain",
+	KanjiVariantFold:            "KanjiVariantFold",
+	StripIVS:                    "StripIVS",
+	CP932ToJIS:                  "CP932ToJIS",
+	JISToCP932:                  "JISToCP932",
 }
 
 var combflagList = []struct {
@@ -329,18 +449,27 @@ var invalidFlagsList = []NormFlag{
 	IsolatedVomToNarrow | IsolatedVomToWide,
 	IsolatedVomToNarrow | IsolatedVomToNonspace,
 	IsolatedVomToWide | IsolatedVomToNonspace,
+	StripVoicing | DecomposeVom,
+	ExpandIterationMark | CollapseIterationMark,
+	CP932ToJIS | JISToCP932,
 }
 
 func (f NormFlag) has(f2 NormFlag) bool { return f&f2 != 0 }
 
-// String returns the name of a flag
-func (f NormFlag) String() string {
+// names returns the names of the flags that f contains.
+func (f NormFlag) names() []string {
 	var ss []string
 	for f2 := NormFlag(1); f2 < normflagMax; f2 <<= 1 {
 		if f.has(f2) {
 			ss = append(ss, normflagMap[f2])
 		}
 	}
+	return ss
+}
+
+// String returns the name of a flag
+func (f NormFlag) String() string {
+	ss := f.names()
 	switch len(ss) {
 	case 0:
 		return "<undefined>"
@@ -14,6 +14,8 @@ var normflag_stringtests = []NormFlag_StringTest{
 	0: {0, "<undefined>"},
 	1: {AlphaToNarrow, "AlphaToNarrow"},
 	2: {LatinToNarrow, "(AlphaToNarrow | DigitToNarrow | SymbolToNarrow)"},
+	3: {JISToCP932, "JISToCP932"},
+	4: {StripIVS | CP932ToJIS, "(StripIVS | CP932ToJIS)"},
 }
 
 func TestNormFlag_String(t *testing.T) {
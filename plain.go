@@ -0,0 +1,52 @@
+package gaga
+
+// plainString returns the decomposition that the enclosed or
+// compatibility character r is expanded to according to the f, or false
+// if the r is not expanded.
+func (f NormFlag) plainString(r rune) (string, bool) {
+	if !f.has(EnclosedToPlain | CompatToPlain) {
+		return "", false
+	}
+	c, ok := findUnichar(r)
+	if !ok {
+		return "", false
+	}
+	switch {
+	case c.category == ctEnclosed && f.has(EnclosedToPlain),
+		c.category == ctCompat && f.has(CompatToPlain):
+		// TEST_Hn3vQe8D ensured that all enclosed and compatibility
+		// characters have a decomposition.
+		return decompTable[r], true
+	default:
+		return "", false
+	}
+}
+
+// compilePlain normalizes the decompositions of the enclosed and
+// compatibility characters according to the flag.
+func (n *Normalizer) compilePlain() {
+	if !n.flag.has(EnclosedToPlain | CompatToPlain) {
+		n.plainOut = nil
+		return
+	}
+	n.plainOut = make(map[rune][]byte, len(decompTable))
+	for r := range decompTable {
+		if s, ok := n.flag.plainString(r); ok {
+			n.plainOut[r] = n.flag.appendRunes(nil, s)
+		}
+	}
+}
+
+// plain returns the normalized decomposition that the r is expanded to,
+// or false if the r is not expanded.
+func (n *Normalizer) plain(r rune) ([]byte, bool) {
+	s, ok := n.flag.plainString(r)
+	if !ok {
+		return nil, false
+	}
+	if out, ok := n.plainOut[r]; ok {
+		return out, true
+	}
+	// The Normalizer was not created by Norm.
+	return n.flag.appendRunes(nil, s), true
+}
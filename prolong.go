@@ -0,0 +1,102 @@
+package gaga
+
+// ctxClass is the class of the rune that precedes the rune being
+// normalized, on which the ProlongedSoundMarkByContext depends.
+type ctxClass uint8
+
+const (
+	ctxOther ctxClass = iota
+	ctxKana           // a kana letter or a prolonged sound mark
+	ctxAlnum          // a Latin letter or digit
+)
+
+// runeClass returns the class that the r makes for the rune that
+// follows it.
+func runeClass(r rune) ctxClass {
+	c, ok := findUnichar(r)
+	if !ok {
+		return ctxOther
+	}
+	switch c.category {
+	case ctKanaLetter:
+		return ctxKana
+	case ctLatinLetter, ctLatinDigit:
+		return ctxAlnum
+	default:
+		return ctxOther
+	}
+}
+
+// isProlongedSoundMark reports whether r is a prolonged sound mark.
+func isProlongedSoundMark(r rune) bool {
+	return r == 'ー' || r == 'ｰ'
+}
+
+// isHyphen reports whether r is a hyphen-minus.
+func isHyphen(r rune) bool {
+	return r == '-' || r == '－'
+}
+
+// isDashLike reports whether r is a dash-like character that may be
+// used in place of a prolonged sound mark or a hyphen.
+func isDashLike(r rune) bool {
+	switch r {
+	case '-', '－', 'ー', 'ｰ',
+		'‐', // HYPHEN
+		'‑', // NON-BREAKING HYPHEN
+		'‒', // FIGURE DASH
+		'–', // EN DASH
+		'—', // EM DASH
+		'―', // HORIZONTAL BAR
+		'−': // MINUS SIGN
+		return true
+	default:
+		return false
+	}
+}
+
+// isNarrow reports whether r is a half-width character.
+func isNarrow(r rune) bool {
+	c, ok := findUnichar(r)
+	return ok && c.charWidth == cwNarrow
+}
+
+// dashByContext returns the rune that the dash-like r at in[p:] is
+// replaced with in the ctx, or 0 if the r is left to the other flags.
+// The replacement has the same width as the rune that precedes it.
+// short is true if the result may depend on bytes that are not in the
+// in yet.
+func dashByContext(in *input, p int, r rune, size int, ctx normCtx, atEOF bool) (to rune, short bool) {
+	switch ctx.class {
+	case ctxKana:
+		if isProlongedSoundMark(r) {
+			return 0, false
+		}
+		if isNarrow(ctx.last) {
+			return 'ｰ', false
+		}
+		return 'ー', false
+	case ctxAlnum:
+		if isHyphen(r) {
+			return 0, false
+		}
+		next := p + size
+		if next >= in.len() || !in.fullRune(next) {
+			if !atEOF {
+				return 0, true
+			}
+			if next >= in.len() {
+				return 0, false
+			}
+		}
+		if r2, _ := in.decodeRune(next); runeClass(r2) == ctxAlnum {
+			if isNarrow(ctx.last) {
+				return '-', false
+			}
+			return '－', false
+		}
+		return 0, false
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,97 @@
+package gaga
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+)
+
+type ProlongedSoundMarkTest struct {
+	flag NormFlag
+	in   string
+	out  string
+}
+
+var prolongedsoundmarktests = []ProlongedSoundMarkTest{
+	0:  {ProlongedSoundMarkByContext, "", ""},
+	1:  {ProlongedSoundMarkByContext, "カード", "カード"},
+	2:  {ProlongedSoundMarkByContext, "ｶ-ﾄﾞ", "ｶｰﾄﾞ"},
+	3:  {ProlongedSoundMarkByContext, "カ－ド", "カード"},
+	4:  {ProlongedSoundMarkByContext, "カ―ド", "カード"},
+	5:  {ProlongedSoundMarkByContext, "カ‐ド", "カード"},
+	6:  {ProlongedSoundMarkByContext, "カ—", "カー"},
+	7:  {ProlongedSoundMarkByContext, "カ−−", "カーー"},
+	8:  {ProlongedSoundMarkByContext, "が‐", "がー"},
+	9:  {ProlongedSoundMarkByContext, "か゛‐", "か゛ー"},
+	10: {ProlongedSoundMarkByContext, "03ー1234ー5678", "03-1234-5678"},
+	11: {ProlongedSoundMarkByContext, "０３ー１２３４", "０３－１２３４"},
+	12: {ProlongedSoundMarkByContext, "A―B", "A-B"},
+	13: {ProlongedSoundMarkByContext, "A―", "A―"},
+	14: {ProlongedSoundMarkByContext, "A―あ", "A―あ"},
+	15: {ProlongedSoundMarkByContext, "A--B", "A--B"},
+	16: {ProlongedSoundMarkByContext, "―B", "―B"},
+	17: {ProlongedSoundMarkByContext, "漢―字", "漢―字"},
+	18: {ProlongedSoundMarkByContext, "漢ー1", "漢ー1"},
+	19: {ProlongedSoundMarkByContext, "ー1", "ー1"},
+	20: {ProlongedSoundMarkByContext, "アｰ", "アｰ"},
+	21: {ProlongedSoundMarkByContext | Fold, "ｶ-ﾄﾞ", "カード"},
+	22: {ProlongedSoundMarkByContext | Fold, "０３ー１２３４", "03-1234"},
+	23: {ProlongedSoundMarkByContext | KanaToNarrow, "カ−ド", "ｶｰﾄﾞ"},
+	24: {ProlongedSoundMarkByContext | LatinToWide, "03ー12", "０３－１２"},
+	25: {ProlongedSoundMarkByContext | KanaToHiragana, "カ−ド", "かーど"},
+	26: {Fold, "ｶ-ﾄﾞ", "カ-ド"},
+	27: {ProlongedSoundMarkByContext, "ｶﾞ―ｼﾞｰ", "ｶﾞｰｼﾞｰ"},
+	28: {ProlongedSoundMarkByContext, "Ａ―Ｂ-C", "Ａ－Ｂ-C"},
+}
+
+func TestProlongedSoundMarkByContext(t *testing.T) {
+	for i, tt := range prolongedsoundmarktests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if have := n.String(tt.in); have != tt.out {
+			t.Errorf("#%d %s, String(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, have, tt.out)
+		}
+		if have := string(n.Bytes([]byte(tt.in))); have != tt.out {
+			t.Errorf("#%d %s, Bytes(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, have, tt.out)
+		}
+		if have, _ := n.StringWithOffsets(tt.in); have != tt.out {
+			t.Errorf("#%d %s, StringWithOffsets(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, have, tt.out)
+		}
+		if have := n.IsNormalized(tt.in); have != (tt.in == tt.out) {
+			t.Errorf("#%d %s, IsNormalized(%q) = %v", i, tt.flag, tt.in, have)
+		}
+	}
+}
+
+func TestProlongedSoundMarkByContext_Stream(t *testing.T) {
+	for i, tt := range prolongedsoundmarktests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, err := ioutil.ReadAll(n.NewReader(iotest.OneByteReader(strings.NewReader(tt.in))))
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, NewReader(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, out, tt.out)
+		}
+		r := transform.NewReader(iotest.OneByteReader(strings.NewReader(tt.in)), n.Transformer())
+		out, err = ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, Transformer(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.in, out, tt.out)
+		}
+	}
+}
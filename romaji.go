@@ -0,0 +1,356 @@
+package gaga
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// RomajiSystem is the romanization system used by Romanize, which may be
+// combined with a long vowel style by the | operator.
+type RomajiSystem int
+
+// Constants to identify the romanization systems.
+const (
+	// Hepburn is the modified Hepburn romanization.
+	// Example: "しんぶんし" => "shinbunshi"
+	Hepburn RomajiSystem = iota
+
+	// Kunrei is the Kunrei-shiki romanization (ISO 3602).
+	// Example: "しんぶんし" => "sinbunsi"
+	Kunrei
+
+	// NihonShiki is the Nihon-shiki romanization, which distinguishes
+	// [ぢ][づ][を] from [じ][ず][お].
+	// Example: "ちぢむ" => "tidimu"
+	NihonShiki
+
+	// Passport is the Hepburn romanization used for the Japanese
+	// passports, which is written in upper case, writes [ん] before
+	// [b][m][p] as [M] and omits the long vowels.
+	// Example: "なんば" => "NAMBA",  "おおの" => "ONO"
+	Passport
+
+	romajiSystemMask RomajiSystem = 0x0f
+)
+
+// Constants to identify the long vowel styles, which are combined with
+// a romanization system. The long vowels are written as they are in
+// kana unless one of these is specified. At most one of them may be
+// specified, and LongVowelOH is the only one for the Passport.
+const (
+	// LongVowelMacron writes the long vowels with a macron.
+	// Example: "とうきょう" => "tōkyō",  "らーめん" => "rāmen"
+	LongVowelMacron RomajiSystem = 1 << (iota + 4)
+
+	// LongVowelCircumflex writes the long vowels with a circumflex.
+	// Example: "とうきょう" => "tôkyô",  "らーめん" => "râmen"
+	LongVowelCircumflex
+
+	// LongVowelOH writes the long vowel of [o] as [OH], which works only
+	// with the Passport.
+	// Example: "おおの" => "OHNO"
+	LongVowelOH
+
+	longVowelMask RomajiSystem = 0x70
+)
+
+// validate returns an error if the system is not one of the
+// romanization systems combined with at most one long vowel style that
+// works in it.
+func (system RomajiSystem) validate() error {
+	style := system & longVowelMask
+	system &^= longVowelMask
+	switch {
+	case system&^romajiSystemMask != 0 || system > Passport:
+		return fmt.Errorf("invalid romanization system: %#x", int(system|style))
+	case style&(style-1) != 0:
+		return fmt.Errorf("invalid romanization system: %#x has more than one long vowel style", int(system|style))
+	case style == LongVowelOH && system != Passport:
+		return fmt.Errorf("invalid romanization system: LongVowelOH works only with Passport")
+	case style != 0 && style != LongVowelOH && system == Passport:
+		return fmt.Errorf("invalid romanization system: Passport works only with LongVowelOH")
+	}
+	return nil
+}
+
+// romaji is a kana syllable written in each romanization system.
+type romaji struct {
+	hepburn, kunrei, nihon string
+}
+
+func (ro romaji) in(system RomajiSystem) string {
+	switch system {
+	case Kunrei:
+		return ro.kunrei
+	case NihonShiki:
+		return ro.nihon
+	default:
+		return ro.hepburn
+	}
+}
+
+var romajiTable = func() map[string]romaji {
+	m := map[string]romaji{
+		"あ": {"a", "a", "a"}, "い": {"i", "i", "i"}, "う": {"u", "u", "u"},
+		"え": {"e", "e", "e"}, "お": {"o", "o", "o"},
+		"か": {"ka", "ka", "ka"}, "き": {"ki", "ki", "ki"}, "く": {"ku", "ku", "ku"},
+		"け": {"ke", "ke", "ke"}, "こ": {"ko", "ko", "ko"},
+		"が": {"ga", "ga", "ga"}, "ぎ": {"gi", "gi", "gi"}, "ぐ": {"gu", "gu", "gu"},
+		"げ": {"ge", "ge", "ge"}, "ご": {"go", "go", "go"},
+		"さ": {"sa", "sa", "sa"}, "し": {"shi", "si", "si"}, "す": {"su", "su", "su"},
+		"せ": {"se", "se", "se"}, "そ": {"so", "so", "so"},
+		"ざ": {"za", "za", "za"}, "じ": {"ji", "zi", "zi"}, "ず": {"zu", "zu", "zu"},
+		"ぜ": {"ze", "ze", "ze"}, "ぞ": {"zo", "zo", "zo"},
+		"た": {"ta", "ta", "ta"}, "ち": {"chi", "ti", "ti"}, "つ": {"tsu", "tu", "tu"},
+		"て": {"te", "te", "te"}, "と": {"to", "to", "to"},
+		"だ": {"da", "da", "da"}, "ぢ": {"ji", "zi", "di"}, "づ": {"zu", "zu", "du"},
+		"で": {"de", "de", "de"}, "ど": {"do", "do", "do"},
+		"な": {"na", "na", "na"}, "に": {"ni", "ni", "ni"}, "ぬ": {"nu", "nu", "nu"},
+		"ね": {"ne", "ne", "ne"}, "の": {"no", "no", "no"},
+		"は": {"ha", "ha", "ha"}, "ひ": {"hi", "hi", "hi"}, "ふ": {"fu", "hu", "hu"},
+		"へ": {"he", "he", "he"}, "ほ": {"ho", "ho", "ho"},
+		"ば": {"ba", "ba", "ba"}, "び": {"bi", "bi", "bi"}, "ぶ": {"bu", "bu", "bu"},
+		"べ": {"be", "be", "be"}, "ぼ": {"bo", "bo", "bo"},
+		"ぱ": {"pa", "pa", "pa"}, "ぴ": {"pi", "pi", "pi"}, "ぷ": {"pu", "pu", "pu"},
+		"ぺ": {"pe", "pe", "pe"}, "ぽ": {"po", "po", "po"},
+		"ま": {"ma", "ma", "ma"}, "み": {"mi", "mi", "mi"}, "む": {"mu", "mu", "mu"},
+		"め": {"me", "me", "me"}, "も": {"mo", "mo", "mo"},
+		"や": {"ya", "ya", "ya"}, "ゆ": {"yu", "yu", "yu"}, "よ": {"yo", "yo", "yo"},
+		"ら": {"ra", "ra", "ra"}, "り": {"ri", "ri", "ri"}, "る": {"ru", "ru", "ru"},
+		"れ": {"re", "re", "re"}, "ろ": {"ro", "ro", "ro"},
+		"わ": {"wa", "wa", "wa"}, "ゐ": {"i", "i", "wi"}, "ゑ": {"e", "e", "we"},
+		"を": {"o", "o", "wo"}, "ゔ": {"vu", "vu", "vu"},
+		"ぁ": {"a", "a", "a"}, "ぃ": {"i", "i", "i"}, "ぅ": {"u", "u", "u"},
+		"ぇ": {"e", "e", "e"}, "ぉ": {"o", "o", "o"},
+		"ゃ": {"ya", "ya", "ya"}, "ゅ": {"yu", "yu", "yu"}, "ょ": {"yo", "yo", "yo"},
+		"ゎ": {"wa", "wa", "wa"}, "ゕ": {"ka", "ka", "ka"}, "ゖ": {"ke", "ke", "ke"},
+
+		// The syllables for the loanwords.
+		"ふぁ": {"fa", "fa", "fa"}, "ふぃ": {"fi", "fi", "fi"}, "ふぇ": {"fe", "fe", "fe"},
+		"ふぉ": {"fo", "fo", "fo"}, "ふゅ": {"fyu", "fyu", "fyu"},
+		"てぃ": {"ti", "ti", "ti"}, "でぃ": {"di", "di", "di"}, "とぅ": {"tu", "tu", "tu"},
+		"どぅ": {"du", "du", "du"}, "てゅ": {"tyu", "tyu", "tyu"}, "でゅ": {"dyu", "dyu", "dyu"},
+		"うぃ": {"wi", "wi", "wi"}, "うぇ": {"we", "we", "we"}, "うぉ": {"wo", "wo", "wo"},
+		"ゔぁ": {"va", "va", "va"}, "ゔぃ": {"vi", "vi", "vi"}, "ゔぇ": {"ve", "ve", "ve"},
+		"ゔぉ": {"vo", "vo", "vo"}, "ゔゅ": {"vyu", "vyu", "vyu"},
+		"つぁ": {"tsa", "tsa", "tsa"}, "つぃ": {"tsi", "tsi", "tsi"},
+		"つぇ": {"tse", "tse", "tse"}, "つぉ": {"tso", "tso", "tso"},
+		"いぇ": {"ye", "ye", "ye"},
+	}
+	// The youon, such as [き][ゃ], and [し][ぇ] etc. for the loanwords.
+	stems := []struct {
+		kana string
+		stem romaji
+		e    bool // whether the stem has the syllable with [ぇ]
+	}{
+		{"き", romaji{"ky", "ky", "ky"}, false},
+		{"ぎ", romaji{"gy", "gy", "gy"}, false},
+		{"し", romaji{"sh", "sy", "sy"}, true},
+		{"じ", romaji{"j", "zy", "zy"}, true},
+		{"ち", romaji{"ch", "ty", "ty"}, true},
+		{"ぢ", romaji{"j", "zy", "dy"}, false},
+		{"に", romaji{"ny", "ny", "ny"}, false},
+		{"ひ", romaji{"hy", "hy", "hy"}, false},
+		{"び", romaji{"by", "by", "by"}, false},
+		{"ぴ", romaji{"py", "py", "py"}, false},
+		{"み", romaji{"my", "my", "my"}, false},
+		{"り", romaji{"ry", "ry", "ry"}, false},
+	}
+	for _, s := range stems {
+		for _, y := range []struct{ kana, vowel string }{
+			{"ゃ", "a"}, {"ゅ", "u"}, {"ょ", "o"}, {"ぇ", "e"},
+		} {
+			if y.kana == "ぇ" && !s.e {
+				continue
+			}
+			m[s.kana+y.kana] = romaji{
+				s.stem.hepburn + y.vowel,
+				s.stem.kunrei + y.vowel,
+				s.stem.nihon + y.vowel,
+			}
+		}
+	}
+	return m
+}()
+
+const romajiNormFlag = LatinToNarrow | KanaToHiragana | ExpandIterationMark | ModernizeKana
+
+// romajiNormalizer normalizes the text into Hiragana for Romanize.
+var romajiNormalizer = func() *Normalizer {
+	n, err := Norm(romajiNormFlag)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}()
+
+// nihonShikiNormalizer is the romajiNormalizer for the NihonShiki, which
+// keeps [ゐ][ゑ] from being modernized into [い][え].
+var nihonShikiNormalizer = func() *Normalizer {
+	n, err := NormWithRules(romajiNormFlag, Rules{'ゐ': "ゐ", 'ゑ': "ゑ", 'ヰ': "ゐ", 'ヱ': "ゑ"})
+	if err != nil {
+		panic(err)
+	}
+	return n
+}()
+
+// findSyllable returns the romaji of the longest syllable at the
+// beginning of the s and its length in bytes, or 0 if the s does not
+// begin with a syllable.
+func findSyllable(s string, system RomajiSystem) (string, int) {
+	for _, l := range []int{2, 1} {
+		var size int
+		for i := 0; i < l && size < len(s); i++ {
+			_, n := utf8.DecodeRuneInString(s[size:])
+			size += n
+		}
+		if ro, ok := romajiTable[s[:size]]; ok && utf8.RuneCountInString(s[:size]) == l {
+			return ro.in(system), size
+		}
+	}
+	return "", 0
+}
+
+func isRomajiVowel(c byte) bool {
+	return strings.IndexByte("aiueo", c) >= 0
+}
+
+// isLongVowel reports whether the vowel v2 that follows the v1 makes
+// a long vowel in the system.
+func isLongVowel(v1, v2 byte, system RomajiSystem) bool {
+	switch string([]byte{v1, v2}) {
+	case "oo", "ou", "uu":
+		return true
+	case "aa", "ee":
+		return system != Passport
+	default:
+		return false
+	}
+}
+
+var (
+	macronVowels     = map[byte]string{'a': "ā", 'i': "ī", 'u': "ū", 'e': "ē", 'o': "ō"}
+	circumflexVowels = map[byte]string{'a': "â", 'i': "î", 'u': "û", 'e': "ê", 'o': "ô"}
+)
+
+// Romanize transliterates the kana in the s into romaji according to
+// the system, which may be combined with a long vowel style such as
+// LongVowelMacron. The s is normalized into Hiragana first, so that the
+// kana of any width or case is accepted. The characters other than kana
+// are left as they are, even for the Passport. A sokuon [っ] that does
+// not precede a consonant is written as ['], such as "あっ" => "a'".
+// Romanize returns an error if the system is not valid, such as the one
+// combined with more than one long vowel style.
+func Romanize(s string, system RomajiSystem) (string, error) {
+	if err := system.validate(); err != nil {
+		return "", err
+	}
+	style := system & longVowelMask
+	system &= romajiSystemMask
+	if system == NihonShiki {
+		s = nihonShikiNormalizer.String(s)
+	} else {
+		s = romajiNormalizer.String(s)
+	}
+
+	out := make([]byte, 0, len(s))
+	var vowel byte // the vowel that the last syllable ends with
+	sokuon := false
+	kana := 0 // the start of the romaji that follows the last non-kana character
+	for p := 0; p < len(s); {
+		ro, size := findSyllable(s[p:], system)
+		if sokuon && (size == 0 || isRomajiVowel(ro[0])) {
+			// The sokuon does not double a consonant.
+			out = append(out, '\'')
+			vowel, sokuon = 0, false
+		}
+		if size == 0 {
+			r, n := utf8.DecodeRuneInString(s[p:])
+			switch r {
+			case 'っ':
+				sokuon = true
+				p += n
+				continue
+			case 'ん':
+				next, _ := findSyllable(s[p+n:], system)
+				switch {
+				case system == Passport && next != "" && strings.IndexByte("bmp", next[0]) >= 0:
+					out = append(out, 'm')
+				case system != Passport && next != "" && (isRomajiVowel(next[0]) || next[0] == 'y'):
+					out = append(out, "n'"...)
+				default:
+					out = append(out, 'n')
+				}
+			case 'ー', 'ｰ':
+				if vowel == 0 {
+					out = append(out, '-')
+				} else {
+					out = appendLongVowel(out, vowel, vowel, system, style)
+				}
+			default:
+				if system == Passport {
+					toUpper(out[kana:])
+				}
+				out = append(out, string(r)...)
+				kana = len(out)
+			}
+			vowel = 0
+			p += n
+			continue
+		}
+		if vowel != 0 && len(ro) == 1 && !sokuon && isLongVowel(vowel, ro[0], system) {
+			out = appendLongVowel(out, vowel, ro[0], system, style)
+			vowel = 0
+			p += size
+			continue
+		}
+		if sokuon && !isRomajiVowel(ro[0]) {
+			if strings.HasPrefix(ro, "ch") {
+				out = append(out, 't')
+			} else {
+				out = append(out, ro[0])
+			}
+		}
+		out = append(out, ro...)
+		vowel, sokuon = 0, false
+		if c := ro[len(ro)-1]; isRomajiVowel(c) {
+			vowel = c
+		}
+		p += size
+	}
+	if sokuon {
+		out = append(out, '\'')
+	}
+	if system == Passport {
+		toUpper(out[kana:])
+	}
+	return string(out), nil
+}
+
+// toUpper converts the ASCII letters of the b into upper case in place.
+func toUpper(b []byte) {
+	for i, c := range b {
+		if 'a' <= c && c <= 'z' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+}
+
+// appendLongVowel appends the second vowel v2 of the long vowel, of which
+// the first vowel v1 is at the end of the dst, in the style.
+func appendLongVowel(dst []byte, v1, v2 byte, system, style RomajiSystem) []byte {
+	switch {
+	case system == Passport:
+		if style == LongVowelOH && v1 == 'o' {
+			dst = append(dst, 'h')
+		}
+		return dst
+	case style == LongVowelMacron:
+		return append(dst[:len(dst)-1], macronVowels[v1]...)
+	case style == LongVowelCircumflex:
+		return append(dst[:len(dst)-1], circumflexVowels[v1]...)
+	default:
+		return append(dst, v2)
+	}
+}
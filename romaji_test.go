@@ -0,0 +1,93 @@
+package gaga
+
+import (
+	"testing"
+)
+
+type RomanizeTest struct {
+	system RomajiSystem
+	in     string
+	out    string
+}
+
+var romanizetests = []RomanizeTest{
+	0:  {Hepburn, "", ""},
+	1:  {Hepburn, "しんぶんし", "shinbunshi"},
+	2:  {Kunrei, "しんぶんし", "sinbunsi"},
+	3:  {NihonShiki, "しんぶんし", "sinbunsi"},
+	4:  {Hepburn, "ちぢむ", "chijimu"},
+	5:  {Kunrei, "ちぢむ", "tizimu"},
+	6:  {NihonShiki, "ちぢむ", "tidimu"},
+	7:  {Hepburn, "きゃりーぱみゅぱみゅ", "kyariipamyupamyu"},
+	8:  {Kunrei, "しゃしん", "syasin"},
+	9:  {Hepburn, "じゃんけん", "janken"},
+	10: {Hepburn, "きっぷ", "kippu"},
+	11: {Hepburn, "まっちゃ", "matcha"},
+	12: {Kunrei, "まっちゃ", "mattya"},
+	13: {Hepburn, "あっ", "a'"},
+	14: {Hepburn, "きんようび", "kin'youbi"},
+	15: {Hepburn, "はんい", "han'i"},
+	16: {Hepburn, "さんま", "sanma"},
+	17: {Hepburn, "とうきょう", "toukyou"},
+	18: {Hepburn | LongVowelMacron, "とうきょう", "tōkyō"},
+	19: {Hepburn | LongVowelCircumflex, "とうきょう", "tôkyô"},
+	20: {Kunrei | LongVowelCircumflex, "とうきょう", "tôkyô"},
+	21: {Hepburn, "らーめん", "raamen"},
+	22: {Hepburn | LongVowelMacron, "らーめん", "rāmen"},
+	23: {Hepburn | LongVowelMacron, "おおさか", "ōsaka"},
+	24: {Hepburn | LongVowelMacron, "おにいさん", "oniisan"},
+	25: {Hepburn | LongVowelMacron, "せんせい", "sensei"},
+	26: {Passport, "なんば", "NAMBA"},
+	27: {Passport, "おおの", "ONO"},
+	28: {Passport | LongVowelOH, "おおの", "OHNO"},
+	29: {Passport, "きんようび", "KINYOBI"},
+	30: {Passport, "ゆうこ", "YUKO"},
+	31: {Hepburn, "ﾄｳｷｮｳ", "toukyou"},
+	32: {Hepburn, "カタカナ", "katakana"},
+	33: {Hepburn, "ファイル", "fairu"},
+	34: {Hepburn, "ヴァイオリン", "vaiorin"},
+	35: {Hepburn, "いすゞ", "isuzu"},
+	36: {Hepburn, "を", "o"},
+	37: {NihonShiki, "を", "wo"},
+	38: {Hepburn, "Go言語", "Go言語"},
+	39: {Hepburn, "ＧＯ　ごー", "GO goo"},
+	40: {Hepburn, "ー", "-"},
+	41: {Hepburn, "ｶﾞｰﾄﾞ", "gaado"},
+	42: {Passport, "あっ", "A'"},
+	43: {Hepburn, "えっ!?", "e'!?"},
+	44: {Hepburn, "あっあ", "a'a"},
+	45: {Passport, "はる abc", "HARU abc"},
+	46: {Passport, "Goごう", "GoGO"},
+	47: {Passport | LongVowelOH, "とうきょう Tokyo", "TOHKYOH Tokyo"},
+	48: {NihonShiki, "ゐゑヰヱ", "wiwewiwe"},
+	49: {Kunrei, "ゐゑヰヱ", "ieie"},
+	50: {NihonShiki, "ヸゟ", "viyori"},
+}
+
+func TestRomanize(t *testing.T) {
+	for i, tt := range romanizetests {
+		have, err := Romanize(tt.in, tt.system)
+		if err != nil {
+			t.Errorf("#%d Romanize(%q, %d): %s", i, tt.in, tt.system, err.Error())
+			continue
+		}
+		if have != tt.out {
+			t.Errorf("#%d Romanize(%q, %d)\n\thave: %q\n\twant: %q", i, tt.in, tt.system, have, tt.out)
+		}
+	}
+}
+
+func TestRomanizeInvalidSystem(t *testing.T) {
+	for i, system := range []RomajiSystem{
+		0: LongVowelMacron | LongVowelCircumflex,
+		1: Passport | LongVowelMacron | LongVowelOH,
+		2: Passport + 1,
+		3: 0x80,
+		4: Hepburn | LongVowelOH,
+		5: Passport | LongVowelMacron,
+	} {
+		if have, err := Romanize("あ", system); err == nil {
+			t.Errorf("#%d Romanize(%q, %#x) = %q, want: error", i, "あ", int(system), have)
+		}
+	}
+}
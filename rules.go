@@ -0,0 +1,200 @@
+package gaga
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Rules is a set of custom normalization rules, each of which replaces
+// a rune with a string. The rules take precedence over the normalization
+// flags, and the replacements are normalized according to the flags.
+//
+// If a replacement contains a rune that has a rule, the rune is also
+// replaced, so the rules must not be cyclic. A rule that replaces a rune
+// with itself keeps the rune from being normalized by the flags.
+type Rules map[rune]string
+
+// NormWithRules is like Norm, but creates a new Normalizer that also
+// applies the rules together with the flag.
+func NormWithRules(flag NormFlag, rules Rules) (*Normalizer, error) {
+	err := flag.validate()
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := rules.resolve()
+	if err != nil {
+		return nil, err
+	}
+	n := Normalizer{flag: flag, rules: resolved}
+	n.compile()
+	return &n, nil
+}
+
+// compileRules normalizes the replacements of the rules according to
+// the flag. The resolved replacements contain no rune that has a rule,
+// except for the runes that the rules keep as they are.
+func (n *Normalizer) compileRules() {
+	if len(n.rules) == 0 {
+		n.ruleOut = nil
+		return
+	}
+	flagOnly := uncompiled(n.flag)
+	n.ruleOut = make(map[rune][]byte, len(n.rules))
+	for r, s := range n.rules {
+		var out []byte
+		start := 0
+		for i, r2 := range s {
+			if _, ok := n.rules[r2]; ok {
+				out = flagOnly.AppendString(out, s[start:i])
+				out = append(out, string(r2)...)
+				start = i + utf8.RuneLen(r2)
+			}
+		}
+		n.ruleOut[r] = flagOnly.AppendString(out, s[start:])
+	}
+}
+
+// Validate reports an error if a rule has an invalid rune or the rules
+// are cyclic.
+func (rs Rules) Validate() error {
+	_, err := rs.resolve()
+	return err
+}
+
+// sortedRunes returns the runes that have a rule in ascending order,
+// so that the errors do not depend on the order of the map iteration.
+func (rs Rules) sortedRunes() []rune {
+	runes := make([]rune, 0, len(rs))
+	for r := range rs {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
+
+// resolve returns a copy of the rules in which the runes of every
+// replacement that have a rule are replaced recursively.
+func (rs Rules) resolve() (Rules, error) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	resolved := make(Rules, len(rs))
+	state := make(map[rune]int, len(rs))
+	var visit func(r rune, path []rune) error
+	visit = func(r rune, path []rune) error {
+		switch state[r] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("cyclic rules: %s", formatRunes(append(path, r)))
+		}
+		if rs[r] == string(r) {
+			// The rule keeps the rune as it is.
+			resolved[r] = rs[r]
+			state[r] = done
+			return nil
+		}
+		state[r] = visiting
+		var sb strings.Builder
+		for _, r2 := range rs[r] {
+			if _, ok := rs[r2]; !ok {
+				sb.WriteRune(r2)
+				continue
+			}
+			if err := visit(r2, append(path, r)); err != nil {
+				return err
+			}
+			sb.WriteString(resolved[r2])
+		}
+		resolved[r] = sb.String()
+		state[r] = done
+		return nil
+	}
+	runes := rs.sortedRunes()
+	for _, r := range runes {
+		if !utf8.ValidRune(r) || r == utf8.RuneError {
+			return nil, fmt.Errorf("invalid rule: %U is not a valid rune", r)
+		}
+		if !utf8.ValidString(rs[r]) {
+			return nil, fmt.Errorf("invalid rule: the replacement for %U is not valid UTF-8", r)
+		}
+	}
+	for _, r := range runes {
+		if err := visit(r, nil); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// parseRuleText parses one side of a rule, which is either a sequence
+// of code points such as "U+FF76 U+FF9E", "<empty>" or the literal text.
+func parseRuleText(s string) string {
+	if s == "<empty>" {
+		return ""
+	}
+	fields := strings.Fields(s)
+	runes := make([]rune, 0, len(fields))
+	for _, f := range fields {
+		if len(f) < 6 || !strings.HasPrefix(f, "U+") {
+			return s
+		}
+		u, err := strconv.ParseUint(f[2:], 16, 32)
+		if err != nil {
+			return s
+		}
+		runes = append(runes, rune(u))
+	}
+	return string(runes)
+}
+
+// ParseRules reads the rules in the following text format from r.
+//
+//	# A line beginning with '#' and an empty line are ignored.
+//	〜 -> ～
+//	U+2212 -> -
+//	㈱ -> (株)
+//	U+200B -> <empty>
+//
+// Each line consists of a rune, "->" and its replacement. Both sides are
+// either the literal text or a sequence of code points such as
+// "U+FF76 U+FF9E", and the replacement "<empty>" removes the rune.
+// ParseRules reports an error if a line is malformed, a rune has
+// conflicting rules, or the rules are cyclic.
+func ParseRules(r io.Reader) (Rules, error) {
+	rs := make(Rules)
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if len(s) == 0 || s[0] == '#' {
+			continue
+		}
+		i := strings.Index(s, "->")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: missing \"->\": %q", line, s)
+		}
+		src := parseRuleText(strings.TrimSpace(s[:i]))
+		dst := parseRuleText(strings.TrimSpace(s[i+2:]))
+		if utf8.RuneCountInString(src) != 1 {
+			return nil, fmt.Errorf("line %d: the source must be a single rune: %q", line, s)
+		}
+		key, _ := utf8.DecodeRuneInString(src)
+		if prev, ok := rs[key]; ok && prev != dst {
+			return nil, fmt.Errorf("line %d: conflicting rules for %U: %q and %q", line, key, prev, dst)
+		}
+		rs[key] = dst
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
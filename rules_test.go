@@ -0,0 +1,150 @@
+package gaga
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+type NormWithRulesTest struct {
+	flag  NormFlag
+	rules Rules
+	in    string
+	out   string
+}
+
+var normwithrulestests = []NormWithRulesTest{
+	0:  {Fold, Rules{}, "ＡｱＡ", "AアA"},
+	1:  {Fold, Rules{'〜': "～"}, "1〜2", "1~2"},
+	2:  {LatinToWide, Rules{'〜': "～"}, "1〜2", "１～２"},
+	3:  {Fold, Rules{'−': "-", '‐': "-", 'ー': "ー"}, "a−b‐cー", "a-b-cー"},
+	4:  {Fold, Rules{'㈱': "(株)"}, "㈱ｶﾞｶﾞ", "(株)ガガ"},
+	5:  {Fold, Rules{'Ａ': "x"}, "ＡＢ", "xB"},
+	6:  {Fold, Rules{'a': "b", 'b': "c"}, "ab", "cc"},
+	7:  {Fold, Rules{'​': ""}, "a​b", "ab"},
+	8:  {Fold, Rules{'ｶ': "キ"}, "ｶﾞ", "キ゛"},
+	9:  {Fold, Rules{'ﾞ': ""}, "ｶﾞ", "カ"},
+	10: {Fold, Rules{'ｱ': "ｱ"}, "ｱｲ", "ｱイ"},
+	11: {Fold, Rules{'ｲ': "ｱｱ", 'ｱ': "ｱ"}, "ｲｳ", "ｱｱウ"},
+	12: {Fold, Rules{'x': "ｶｱﾞ", 'ｱ': "ｱ"}, "x", "カｱ゛"},
+	13: {KanaToHiragana, Rules{'A': "ｱ"}, "AB", "あB"},
+}
+
+func TestNormWithRules(t *testing.T) {
+	for i, tt := range normwithrulestests {
+		n, err := NormWithRules(tt.flag, tt.rules)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if have := n.String(tt.in); have != tt.out {
+			t.Errorf("#%d %s, %v, String(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.rules, tt.in, have, tt.out)
+		}
+		if have := string(n.Bytes([]byte(tt.in))); have != tt.out {
+			t.Errorf("#%d %s, %v, Bytes(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.rules, tt.in, have, tt.out)
+		}
+	}
+}
+
+type RulesValidateTest struct {
+	rules Rules
+	err   string
+}
+
+var rulesvalidatetests = []RulesValidateTest{
+	0: {Rules{'a': "b", 'b': "c", 'c': "c"}, ""},
+	1: {Rules{'a': "aa"}, "cyclic rules: U+0061 U+0061"},
+	2: {Rules{'a': "xb", 'b': "yc", 'c': "za"}, "cyclic rules: U+0061 U+0062 U+0063 U+0061"},
+	3: {Rules{0x110000: "a"}, "invalid rule: U+110000 is not a valid rune"},
+	4: {Rules{'a': "\xff"}, "invalid rule: the replacement for U+0061 is not valid UTF-8"},
+}
+
+func TestRules_Validate(t *testing.T) {
+	for i, tt := range rulesvalidatetests {
+		err := tt.rules.Validate()
+		var have string
+		if err != nil {
+			have = err.Error()
+		}
+		if have != tt.err {
+			t.Errorf("#%d %v, Validate()\n\thave: %q\n\twant: %q", i, tt.rules, have, tt.err)
+		}
+		if _, err := NormWithRules(Fold, tt.rules); (err != nil) != (tt.err != "") {
+			t.Errorf("#%d %v, NormWithRules() = %v", i, tt.rules, err)
+		}
+	}
+}
+
+type ParseRulesTest struct {
+	in    string
+	rules Rules
+	err   string
+}
+
+var parserulestests = []ParseRulesTest{
+	0: {"", Rules{}, ""},
+	1: {"# comment\n\n〜 -> ～\nU+2212 -> -\n㈱ -> (株)\nU+200B -> <empty>\n",
+		Rules{'〜': "～", '−': "-", '㈱': "(株)", '​': ""}, ""},
+	2: {"U+FF76 -> U+304B U+3099\n", Rules{'ｶ': "\u304b\u3099"}, ""},
+	3: {"a -> b\na -> b\n", Rules{'a': "b"}, ""},
+	4: {"a -> b\n\na -> c\n", nil, `line 3: conflicting rules for U+0061: "b" and "c"`},
+	5: {"a b\n", nil, `line 1: missing "->": "a b"`},
+	6: {"ab -> c\n", nil, `line 1: the source must be a single rune: "ab -> c"`},
+	7: {" -> c\n", nil, `line 1: the source must be a single rune: "-> c"`},
+	8: {"a -> b\nb -> a\n", nil, "cyclic rules: U+0061 U+0062 U+0061"},
+	9: {"U+ZZZZ -> a\n", Rules{'U': "a"}, `line 1: the source must be a single rune: "U+ZZZZ -> a"`},
+}
+
+func TestParseRules(t *testing.T) {
+	for i, tt := range parserulestests {
+		rs, err := ParseRules(strings.NewReader(tt.in))
+		var have string
+		if err != nil {
+			have = err.Error()
+		}
+		if have != tt.err {
+			t.Errorf("#%d ParseRules(%q)\n\thave error: %q\n\twant error: %q", i, tt.in, have, tt.err)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(rs, tt.rules) {
+			t.Errorf("#%d ParseRules(%q)\n\thave: %v\n\twant: %v", i, tt.in, rs, tt.rules)
+		}
+	}
+}
+
+func TestNormWithRules_Explain(t *testing.T) {
+	n, err := NormWithRules(Fold, Rules{'〜': "～"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := n.Explain("Ａ〜")
+	want := []string{"U+FF21 -> U+0041 (AlphaToNarrow)", "U+301C -> U+007E (rule)"}
+	if len(changes) != len(want) {
+		t.Fatalf("Explain() = %v, want: %v", changes, want)
+	}
+	for i, c := range changes {
+		if c.String() != want[i] {
+			t.Errorf("#%d have: %s, want: %s", i, c, want[i])
+		}
+	}
+}
+
+func TestNormWithRules_Reader(t *testing.T) {
+	for i, tt := range normwithrulestests {
+		n, err := NormWithRules(tt.flag, tt.rules)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		have, err := ioutil.ReadAll(n.NewReader(iotest.OneByteReader(strings.NewReader(tt.in))))
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if string(have) != tt.out {
+			t.Errorf("#%d %s, %v, NewReader(%q)\n\thave: %q\n\twant: %q", i, tt.flag, tt.rules, tt.in, have, tt.out)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package gaga
+
+import (
+	"io"
+)
+
+const streamBufSize = 4096
+
+type normReader struct {
+	n   *Normalizer
+	r   io.Reader
+	src []byte  // input bytes that have not been normalized yet
+	dst []byte  // normalized bytes that have not been read yet
+	ctx normCtx // the context of the first byte of the src
+	err error
+}
+
+// NewReader returns a new io.Reader that reads from r and normalizes
+// the text according to the current normalization mode.
+// A base letter and the voicing modifier that follows it are
+// normalized together, even if they are read by separate calls.
+func (n *Normalizer) NewReader(r io.Reader) io.Reader {
+	return &normReader{
+		n:   n,
+		r:   r,
+		src: make([]byte, 0, streamBufSize),
+		dst: make([]byte, 0, streamBufSize*2),
+	}
+}
+
+func (nr *normReader) fill() {
+	m, err := nr.r.Read(nr.src[len(nr.src):cap(nr.src)])
+	nr.src = nr.src[:len(nr.src)+m]
+	nr.err = err
+
+	var consumed int
+	nr.dst, consumed = nr.n.appendNorm(nr.dst[:0], nr.src, nr.err != nil, &nr.ctx)
+	nr.src = nr.src[:copy(nr.src, nr.src[consumed:])]
+}
+
+// Read implements the io.Reader interface.
+func (nr *normReader) Read(p []byte) (int, error) {
+	for len(nr.dst) == 0 {
+		if nr.err != nil {
+			return 0, nr.err
+		}
+		nr.fill()
+	}
+	m := copy(p, nr.dst)
+	nr.dst = nr.dst[:copy(nr.dst, nr.dst[m:])]
+	return m, nil
+}
+
+type normWriter struct {
+	n   *Normalizer
+	w   io.Writer
+	src []byte // input bytes that have not been normalized yet
+	dst []byte
+	ctx normCtx // the context of the first byte of the src
+}
+
+// NewWriter returns a new io.WriteCloser that normalizes the text
+// written to it according to the current normalization mode and
+// writes the result to w.
+// A base letter at the end of a write is held until the next write,
+// because the voicing modifier may follow it. Close flushes the held
+// input, but does not close w.
+func (n *Normalizer) NewWriter(w io.Writer) io.WriteCloser {
+	return &normWriter{
+		n:   n,
+		w:   w,
+		src: make([]byte, 0, streamBufSize),
+		dst: make([]byte, 0, streamBufSize*2),
+	}
+}
+
+func (nw *normWriter) flush(atEOF bool) error {
+	var consumed int
+	nw.dst, consumed = nw.n.appendNorm(nw.dst[:0], nw.src, atEOF, &nw.ctx)
+	nw.src = nw.src[:copy(nw.src, nw.src[consumed:])]
+	if len(nw.dst) == 0 {
+		return nil
+	}
+	_, err := nw.w.Write(nw.dst)
+	return err
+}
+
+// Write implements the io.Writer interface.
+func (nw *normWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		m := streamBufSize - len(nw.src)
+		if m > len(p) {
+			m = len(p)
+		}
+		nw.src = append(nw.src, p[:m]...)
+		if err := nw.flush(false); err != nil {
+			return written, err
+		}
+		written += m
+		p = p[m:]
+	}
+	return written, nil
+}
+
+// Close flushes the input held by the writer.
+func (nw *normWriter) Close() error {
+	return nw.flush(true)
+}
@@ -0,0 +1,112 @@
+package gaga
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNormalizer_NewReader(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		r := n.NewReader(iotest.OneByteReader(strings.NewReader(tt.in)))
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, NewReader(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestNormalizer_NewWriter(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		var buf bytes.Buffer
+		w := n.NewWriter(&buf)
+		in := []byte(tt.in)
+		for j := range in {
+			// Write one byte at a time, so that every multi-byte rune
+			// and every voicing modifier is split across writes.
+			if _, err := w.Write(in[j : j+1]); err != nil {
+				t.Errorf("#%d: %s", i, err.Error())
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+		}
+		if buf.String() != tt.out {
+			t.Errorf("#%d %s, NewWriter(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, buf.String(), tt.out)
+		}
+	}
+}
+
+type Normalizer_StreamTest struct {
+	flag NormFlag
+	in   []string
+	out  string
+}
+
+var normalizer_streamtests = []Normalizer_StreamTest{
+	0: {Fold, []string{"ｶ", "ﾞ"}, "ガ"},
+	1: {Fold, []string{"\xef\xbd", "\xb6\xef", "\xbe\x9e"}, "ガ"},
+	2: {KanaToHiragana, []string{"ﾊ", "ﾟ", "ﾋﾟ", "ﾌ"}, "ぱぴふ"},
+	3: {DecomposeVom, []string{"か", "゛"}, "か\u3099"},
+	4: {Fold, []string{"ｶ"}, "カ"},
+	5: {Fold, []string{"Ａ\xff", "ｶ"}, "A�カ"},
+}
+
+func TestNormalizer_NewWriterChunks(t *testing.T) {
+	for i, tt := range normalizer_streamtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		var buf bytes.Buffer
+		w := n.NewWriter(&buf)
+		for _, s := range tt.in {
+			if _, err := w.Write([]byte(s)); err != nil {
+				t.Errorf("#%d: %s", i, err.Error())
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+		}
+		if buf.String() != tt.out {
+			t.Errorf("#%d %s, NewWriter(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, buf.String(), tt.out)
+		}
+	}
+}
+
+func TestNormalizer_NewReaderLarge(t *testing.T) {
+	n, err := Norm(Fold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := strings.Repeat("ｶﾞ", streamBufSize)
+	out, err := ioutil.ReadAll(n.NewReader(strings.NewReader(in)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Repeat("ガ", streamBufSize)
+	if string(out) != want {
+		t.Errorf("NewReader(%d bytes), have %d bytes, want %d bytes",
+			len(in), len(out), len(want))
+	}
+}
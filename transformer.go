@@ -0,0 +1,69 @@
+package gaga
+
+import (
+	"golang.org/x/text/transform"
+	"unicode/utf8"
+)
+
+// Transformer implements the transform.SpanningTransformer interface
+// of golang.org/x/text/transform, so that a Normalizer can be chained
+// with the other transformers.
+type Transformer struct {
+	n   *Normalizer
+	ctx *normCtx // the context of the first byte of the next src
+}
+
+// Transformer returns a Transformer that normalizes the text according
+// to the current normalization mode of n.
+func (n *Normalizer) Transformer() Transformer {
+	return Transformer{n, new(normCtx)}
+}
+
+// Reset implements the Reset method of the transform.Transformer
+// interface. Reset discards the context carried over from the text
+// transformed so far.
+func (t Transformer) Reset() {
+	*t.ctx = normCtx{}
+}
+
+// Transform implements the Transform method of the transform.Transformer
+// interface. A base letter at the end of the src is not consumed unless
+// atEOF is true, because the voicing modifier may follow it.
+// The context of the last rune consumed is carried over to the next call
+// for the context-dependent flags.
+func (t Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	var buf [utf8.UTFMax * 2]byte
+	in := inputBytes(src)
+	in.setCtx(*t.ctx)
+	defer func() { *t.ctx = in.ctxAt(nSrc) }()
+	for nSrc < len(src) {
+		out, size := t.n.next(buf[:], &in, nSrc, atEOF)
+		if size == 0 {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		if nDst+len(out) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], out)
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// Span implements the Span method of the transform.SpanningTransformer
+// interface. Like Transform, it carries the context of the last rune
+// spanned over to the next call.
+func (t Transformer) Span(src []byte, atEOF bool) (n int, err error) {
+	in := inputBytes(src)
+	in.setCtx(*t.ctx)
+	n, short := t.n.spanNorm(&in, atEOF)
+	*t.ctx = in.ctxAt(n)
+	switch {
+	case short:
+		return n, transform.ErrShortSrc
+	case n < len(src):
+		return n, transform.ErrEndOfSpan
+	default:
+		return n, nil
+	}
+}
@@ -0,0 +1,158 @@
+package gaga
+
+import (
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTransformer_String(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		out, _, err := transform.String(n.Transformer(), tt.in)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		if out != tt.out {
+			t.Errorf("#%d %s, transform.String(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestTransformer_ShortBuffers(t *testing.T) {
+	for i, tt := range normalizer_stringtests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		tr := n.Transformer()
+		src := []byte(tt.in)
+		// The shortest dst that can hold a rune and its voicing modifier,
+		// or the longest text that a rune is replaced with.
+		size := utf8.UTFMax * 2
+		for _, r := range tt.in {
+			if l := len(n.String(string(r))); l > size {
+				size = l
+			}
+		}
+		dst := make([]byte, size)
+		var out []byte
+		// Feed the src one byte at a time, so that the runes and
+		// the VOM pairs straddle the atEOF boundary.
+		end := 0
+		for p := 0; ; {
+			atEOF := end >= len(src)
+			nDst, nSrc, err := tr.Transform(dst, src[p:end], atEOF)
+			out = append(out, dst[:nDst]...)
+			p += nSrc
+			switch err {
+			case nil:
+				if atEOF {
+					goto done
+				}
+				end++
+			case transform.ErrShortSrc:
+				if atEOF {
+					t.Errorf("#%d: ErrShortSrc at EOF", i)
+					goto done
+				}
+				end++
+			case transform.ErrShortDst:
+				if nDst == 0 && nSrc == 0 {
+					t.Errorf("#%d: ErrShortDst without progress", i)
+					goto done
+				}
+			default:
+				t.Errorf("#%d: %s", i, err.Error())
+				goto done
+			}
+		}
+	done:
+		if string(out) != tt.out {
+			t.Errorf("#%d %s, Transform(%q)\n\thave: %q\n\twant: %q",
+				i, tt.flag, tt.in, out, tt.out)
+		}
+	}
+}
+
+type Transformer_SpanTest struct {
+	flag  NormFlag
+	in    string
+	atEOF bool
+	n     int
+	err   error
+}
+
+var transformer_spantests = []Transformer_SpanTest{
+	0: {Fold, "", true, 0, nil},
+	1: {Fold, "abcアイウ", true, 12, nil},
+	2: {Fold, "abcｱｲｳ", true, 3, transform.ErrEndOfSpan},
+	3: {Fold, "abcア", false, 3, transform.ErrShortSrc},
+	4: {Fold, "abcア", true, 6, nil},
+	5: {Fold, "abcガ", true, 3, transform.ErrEndOfSpan},
+	6: {Fold, "abc\xe3\x82", false, 3, transform.ErrShortSrc},
+	7: {Fold, "abc\xe3\x82", true, 3, transform.ErrEndOfSpan},
+	8: {Fold, "ガ、", false, 6, nil},
+}
+
+func TestTransformer_Span(t *testing.T) {
+	for i, tt := range transformer_spantests {
+		n, err := Norm(tt.flag)
+		if err != nil {
+			t.Errorf("#%d: %s", i, err.Error())
+			continue
+		}
+		have, err := n.Transformer().Span([]byte(tt.in), tt.atEOF)
+		if have != tt.n || err != tt.err {
+			t.Errorf("#%d %s, Span(%q, %v) = (%d, %v), want: (%d, %v)",
+				i, tt.flag, tt.in, tt.atEOF, have, err, tt.n, tt.err)
+		}
+	}
+}
+
+func TestTransformer_SpanInContext(t *testing.T) {
+	n, err := Norm(Fold | ProlongedSoundMarkByContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := n.Transformer()
+	src := []byte("カ-ド")
+	p, err := tr.Span(src, true)
+	if p != 3 || err != transform.ErrEndOfSpan {
+		t.Fatalf("Span(%q, true) = (%d, %v), want: (3, %v)", src, p, err, transform.ErrEndOfSpan)
+	}
+	dst := make([]byte, 16)
+	nDst, _, err := tr.Transform(dst, src[p:], true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(src[:p])+string(dst[:nDst]), "カード"; have != want {
+		t.Errorf("Span and Transform(%q) = %q, want: %q", src, have, want)
+	}
+}
+
+func TestTransformer_Chain(t *testing.T) {
+	n, err := Norm(KanaToHiragana)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := transform.Chain(width.Fold, n.Transformer(), norm.NFC)
+	in := "ＡＢＣｶﾞｷﾞｸﾞ"
+	want := "ABCがぎぐ"
+	out, _, err := transform.String(tr, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != want {
+		t.Errorf("transform.String(%q) = %q, want: %q", in, out, want)
+	}
+}
@@ -8,14 +8,28 @@ import (
 
 func findUnichar(r rune) (c *unichar, ok bool) {
 	switch {
+	case r > widthLast:
+		// Most of the runes are above the tables, of which the width
+		// table is the last.
+		return nil, false
 	case latinFirst <= r && r <= latinLast:
 		return &latinTable[r-latinFirst], true
 	case kanaFirst <= r && r <= kanaLast:
 		return &kanaTable[r-kanaFirst], true
-	case kanaExtFirst <= r && r <= kanaExtLast:
-		return &kanaExtTable[r-kanaExtFirst], true
 	case widthFirst <= r && r <= widthLast:
 		return &widthTable[r-widthFirst], true
+	case kanaExtFirst <= r && r <= kanaExtLast:
+		return &kanaExtTable[r-kanaExtFirst], true
+	// The tables of the characters that have decompositions are looked
+	// up after the frequent ones.
+	case letterlikeFirst <= r && r <= letterlikeLast:
+		return &letterlikeTable[r-letterlikeFirst], true
+	case enclosedFirst <= r && r <= enclosedLast:
+		return &enclosedTable[r-enclosedFirst], true
+	case enclosedCJKFirst <= r && r <= enclosedCJKLast:
+		return &enclosedCJKTable[r-enclosedCJKFirst], true
+	case cjkCompatFirst <= r && r <= cjkCompatLast:
+		return &cjkCompatTable[r-cjkCompatFirst], true
 	default:
 		return nil, false
 	}
@@ -50,6 +64,11 @@ func (c *unichar) getCompatSemivoicedC() *unichar {
 	return findUnicharForSure(c.compatSemivoiced)
 }
 
+func (c *unichar) getCompatSizeC() *unichar {
+	// TEST_Wq4r7ZsL ensured that all compatSize are in the tables.
+	return findUnicharForSure(c.compatSize)
+}
+
 func (c *unichar) existsCompatVoiced() bool {
 	return c.codepoint != c.compatVoiced
 }
@@ -114,6 +133,25 @@ func (c *unichar) toNarrowC() *unichar {
 	return c.getCompatWidthC()
 }
 
+func (c *unichar) toLargeC() *unichar {
+	if c.compatSize == c.codepoint {
+		return c
+	}
+	return c.getCompatSizeC()
+}
+
+// for Hiragana-Katakana letters.
+func (c *unichar) toUnvoicedC() *unichar {
+	switch c.voicing {
+	case vcVoiced:
+		return c.getCompatVoicedC()
+	case vcSemivoiced:
+		return c.getCompatSemivoicedC()
+	default:
+		return c
+	}
+}
+
 // for KanaVom
 func (c *unichar) toLegacyC() *unichar {
 	if c.charCase != ccCombining {
@@ -12,6 +12,8 @@ const (
 	ctKanaLetter // a Hiragana or Katakana letter
 	ctKanaSymbol // a Japanese symbol, voicing modifiers excluded
 	ctKanaVom    // a Japanese voicing modifier (a voiced or semi-voiced sound mark)
+	ctEnclosed   // an enclosed or parenthesized character that has a decomposition
+	ctCompat     // a squared word, unit or letterlike symbol that has a decomposition
 	ctMax
 )
 
@@ -54,6 +56,7 @@ type unichar struct {
 	compatWidth      rune  // A width compatible character (Narrow-Wide)
 	compatVoiced     rune  // A voiced sound compatible character (Unvoiced-Voiced)
 	compatSemivoiced rune  // A semi-voiced sound compatible character (Unvoiced-Semivoiced)
+	compatSize       rune  // A size compatible character (Small-Large)
 }
 
 type unichars []unichar
@@ -61,547 +64,2017 @@ type unichars []unichar
 var latinFirst rune = 0x0020
 var latinLast rune = 0x007F
 var latinTable = unichars{
-	{0x0020, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ' ', '\u3000', ' ', ' '},                // 0x0020
-	{0x0021, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '!', '！', '!', '!'},                     // 0x0021 !
-	{0x0022, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '"', '＂', '"', '"'},                     // 0x0022 "
-	{0x0023, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '#', '＃', '#', '#'},                     // 0x0023 #
-	{0x0024, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '$', '＄', '$', '$'},                     // 0x0024 $
-	{0x0025, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '%', '％', '%', '%'},                     // 0x0025 %
-	{0x0026, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '&', '＆', '&', '&'},                     // 0x0026 &
-	{0x0027, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '\'', '＇', '\'', '\''},                  // 0x0027 '
-	{0x0028, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '(', '（', '(', '('},                     // 0x0028 (
-	{0x0029, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ')', '）', ')', ')'},                     // 0x0029 )
-	{0x002A, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '*', '＊', '*', '*'},                     // 0x002A *
-	{0x002B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '+', '＋', '+', '+'},                     // 0x002B +
-	{0x002C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ',', '，', ',', ','},                     // 0x002C ,
-	{0x002D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '-', '－', '-', '-'},                     // 0x002D -
-	{0x002E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '.', '．', '.', '.'},                     // 0x002E .
-	{0x002F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '/', '／', '/', '/'},                     // 0x002F /
-	{0x0030, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '0', '０', '0', '0'},                      // 0x0030 0
-	{0x0031, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '1', '１', '1', '1'},                      // 0x0031 1
-	{0x0032, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '2', '２', '2', '2'},                      // 0x0032 2
-	{0x0033, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '3', '３', '3', '3'},                      // 0x0033 3
-	{0x0034, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '4', '４', '4', '4'},                      // 0x0034 4
-	{0x0035, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '5', '５', '5', '5'},                      // 0x0035 5
-	{0x0036, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '6', '６', '6', '6'},                      // 0x0036 6
-	{0x0037, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '7', '７', '7', '7'},                      // 0x0037 7
-	{0x0038, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '8', '８', '8', '8'},                      // 0x0038 8
-	{0x0039, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '9', '９', '9', '9'},                      // 0x0039 9
-	{0x003A, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ':', '：', ':', ':'},                     // 0x003A :
-	{0x003B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ';', '；', ';', ';'},                     // 0x003B ;
-	{0x003C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '<', '＜', '<', '<'},                     // 0x003C <
-	{0x003D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '=', '＝', '=', '='},                     // 0x003D =
-	{0x003E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '>', '＞', '>', '>'},                     // 0x003E >
-	{0x003F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '?', '？', '?', '?'},                     // 0x003F ?
-	{0x0040, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '@', '＠', '@', '@'},                     // 0x0040 @
-	{0x0041, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'a', 'Ａ', 'A', 'A'},                         // 0x0041 A
-	{0x0042, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'b', 'Ｂ', 'B', 'B'},                         // 0x0042 B
-	{0x0043, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'c', 'Ｃ', 'C', 'C'},                         // 0x0043 C
-	{0x0044, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'd', 'Ｄ', 'D', 'D'},                         // 0x0044 D
-	{0x0045, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'e', 'Ｅ', 'E', 'E'},                         // 0x0045 E
-	{0x0046, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'f', 'Ｆ', 'F', 'F'},                         // 0x0046 F
-	{0x0047, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'g', 'Ｇ', 'G', 'G'},                         // 0x0047 G
-	{0x0048, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'h', 'Ｈ', 'H', 'H'},                         // 0x0048 H
-	{0x0049, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'i', 'Ｉ', 'I', 'I'},                         // 0x0049 I
-	{0x004A, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'j', 'Ｊ', 'J', 'J'},                         // 0x004A J
-	{0x004B, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'k', 'Ｋ', 'K', 'K'},                         // 0x004B K
-	{0x004C, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'l', 'Ｌ', 'L', 'L'},                         // 0x004C L
-	{0x004D, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'm', 'Ｍ', 'M', 'M'},                         // 0x004D M
-	{0x004E, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'n', 'Ｎ', 'N', 'N'},                         // 0x004E N
-	{0x004F, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'o', 'Ｏ', 'O', 'O'},                         // 0x004F O
-	{0x0050, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'p', 'Ｐ', 'P', 'P'},                         // 0x0050 P
-	{0x0051, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'q', 'Ｑ', 'Q', 'Q'},                         // 0x0051 Q
-	{0x0052, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'r', 'Ｒ', 'R', 'R'},                         // 0x0052 R
-	{0x0053, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 's', 'Ｓ', 'S', 'S'},                         // 0x0053 S
-	{0x0054, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 't', 'Ｔ', 'T', 'T'},                         // 0x0054 T
-	{0x0055, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'u', 'Ｕ', 'U', 'U'},                         // 0x0055 U
-	{0x0056, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'v', 'Ｖ', 'V', 'V'},                         // 0x0056 V
-	{0x0057, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'w', 'Ｗ', 'W', 'W'},                         // 0x0057 W
-	{0x0058, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'x', 'Ｘ', 'X', 'X'},                         // 0x0058 X
-	{0x0059, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'y', 'Ｙ', 'Y', 'Y'},                         // 0x0059 Y
-	{0x005A, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'z', 'Ｚ', 'Z', 'Z'},                         // 0x005A Z
-	{0x005B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '[', '［', '[', '['},                     // 0x005B [
-	{0x005C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '\\', '＼', '\\', '\\'},                  // 0x005C \
-	{0x005D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ']', '］', ']', ']'},                     // 0x005D ]
-	{0x005E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '^', '＾', '^', '^'},                     // 0x005E ^
-	{0x005F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '_', '＿', '_', '_'},                     // 0x005F _
-	{0x0060, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '`', '｀', '`', '`'},                     // 0x0060 `
-	{0x0061, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'A', 'ａ', 'a', 'a'},                         // 0x0061 a
-	{0x0062, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'B', 'ｂ', 'b', 'b'},                         // 0x0062 b
-	{0x0063, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'C', 'ｃ', 'c', 'c'},                         // 0x0063 c
-	{0x0064, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'D', 'ｄ', 'd', 'd'},                         // 0x0064 d
-	{0x0065, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'E', 'ｅ', 'e', 'e'},                         // 0x0065 e
-	{0x0066, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'F', 'ｆ', 'f', 'f'},                         // 0x0066 f
-	{0x0067, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'G', 'ｇ', 'g', 'g'},                         // 0x0067 g
-	{0x0068, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'H', 'ｈ', 'h', 'h'},                         // 0x0068 h
-	{0x0069, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'I', 'ｉ', 'i', 'i'},                         // 0x0069 i
-	{0x006A, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'J', 'ｊ', 'j', 'j'},                         // 0x006A j
-	{0x006B, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'K', 'ｋ', 'k', 'k'},                         // 0x006B k
-	{0x006C, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'L', 'ｌ', 'l', 'l'},                         // 0x006C l
-	{0x006D, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'M', 'ｍ', 'm', 'm'},                         // 0x006D m
-	{0x006E, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'N', 'ｎ', 'n', 'n'},                         // 0x006E n
-	{0x006F, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'O', 'ｏ', 'o', 'o'},                         // 0x006F o
-	{0x0070, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'P', 'ｐ', 'p', 'p'},                         // 0x0070 p
-	{0x0071, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'Q', 'ｑ', 'q', 'q'},                         // 0x0071 q
-	{0x0072, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'R', 'ｒ', 'r', 'r'},                         // 0x0072 r
-	{0x0073, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'S', 'ｓ', 's', 's'},                         // 0x0073 s
-	{0x0074, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'T', 'ｔ', 't', 't'},                         // 0x0074 t
-	{0x0075, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'U', 'ｕ', 'u', 'u'},                         // 0x0075 u
-	{0x0076, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'V', 'ｖ', 'v', 'v'},                         // 0x0076 v
-	{0x0077, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'W', 'ｗ', 'w', 'w'},                         // 0x0077 w
-	{0x0078, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'X', 'ｘ', 'x', 'x'},                         // 0x0078 x
-	{0x0079, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'Y', 'ｙ', 'y', 'y'},                         // 0x0079 y
-	{0x007A, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'Z', 'ｚ', 'z', 'z'},                         // 0x007A z
-	{0x007B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '{', '｛', '{', '{'},                     // 0x007B {
-	{0x007C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '|', '｜', '|', '|'},                     // 0x007C |
-	{0x007D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '}', '｝', '}', '}'},                     // 0x007D }
-	{0x007E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '~', '～', '~', '~'},                     // 0x007E ~
-	{0x007F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '\u007f', '\u007f', '\u007f', '\u007f'}, // 0x007F 
+	{0x0020, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ' ', '\u3000', ' ', ' ', ' '},                     // 0x0020
+	{0x0021, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '!', '！', '!', '!', '!'},                          // 0x0021 !
+	{0x0022, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '"', '＂', '"', '"', '"'},                          // 0x0022 "
+	{0x0023, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '#', '＃', '#', '#', '#'},                          // 0x0023 #
+	{0x0024, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '$', '＄', '$', '$', '$'},                          // 0x0024 $
+	{0x0025, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '%', '％', '%', '%', '%'},                          // 0x0025 %
+	{0x0026, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '&', '＆', '&', '&', '&'},                          // 0x0026 &
+	{0x0027, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '\'', '＇', '\'', '\'', '\''},                      // 0x0027 '
+	{0x0028, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '(', '（', '(', '(', '('},                          // 0x0028 (
+	{0x0029, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ')', '）', ')', ')', ')'},                          // 0x0029 )
+	{0x002A, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '*', '＊', '*', '*', '*'},                          // 0x002A *
+	{0x002B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '+', '＋', '+', '+', '+'},                          // 0x002B +
+	{0x002C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ',', '，', ',', ',', ','},                          // 0x002C ,
+	{0x002D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '-', '－', '-', '-', '-'},                          // 0x002D -
+	{0x002E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '.', '．', '.', '.', '.'},                          // 0x002E .
+	{0x002F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '/', '／', '/', '/', '/'},                          // 0x002F /
+	{0x0030, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '0', '０', '0', '0', '0'},                           // 0x0030 0
+	{0x0031, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '1', '１', '1', '1', '1'},                           // 0x0031 1
+	{0x0032, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '2', '２', '2', '2', '2'},                           // 0x0032 2
+	{0x0033, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '3', '３', '3', '3', '3'},                           // 0x0033 3
+	{0x0034, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '4', '４', '4', '4', '4'},                           // 0x0034 4
+	{0x0035, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '5', '５', '5', '5', '5'},                           // 0x0035 5
+	{0x0036, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '6', '６', '6', '6', '6'},                           // 0x0036 6
+	{0x0037, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '7', '７', '7', '7', '7'},                           // 0x0037 7
+	{0x0038, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '8', '８', '8', '8', '8'},                           // 0x0038 8
+	{0x0039, ctLatinDigit, ccUndefined, cwNarrow, vcUndefined, '9', '９', '9', '9', '9'},                           // 0x0039 9
+	{0x003A, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ':', '：', ':', ':', ':'},                          // 0x003A :
+	{0x003B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ';', '；', ';', ';', ';'},                          // 0x003B ;
+	{0x003C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '<', '＜', '<', '<', '<'},                          // 0x003C <
+	{0x003D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '=', '＝', '=', '=', '='},                          // 0x003D =
+	{0x003E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '>', '＞', '>', '>', '>'},                          // 0x003E >
+	{0x003F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '?', '？', '?', '?', '?'},                          // 0x003F ?
+	{0x0040, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '@', '＠', '@', '@', '@'},                          // 0x0040 @
+	{0x0041, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'a', 'Ａ', 'A', 'A', 'A'},                              // 0x0041 A
+	{0x0042, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'b', 'Ｂ', 'B', 'B', 'B'},                              // 0x0042 B
+	{0x0043, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'c', 'Ｃ', 'C', 'C', 'C'},                              // 0x0043 C
+	{0x0044, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'd', 'Ｄ', 'D', 'D', 'D'},                              // 0x0044 D
+	{0x0045, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'e', 'Ｅ', 'E', 'E', 'E'},                              // 0x0045 E
+	{0x0046, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'f', 'Ｆ', 'F', 'F', 'F'},                              // 0x0046 F
+	{0x0047, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'g', 'Ｇ', 'G', 'G', 'G'},                              // 0x0047 G
+	{0x0048, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'h', 'Ｈ', 'H', 'H', 'H'},                              // 0x0048 H
+	{0x0049, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'i', 'Ｉ', 'I', 'I', 'I'},                              // 0x0049 I
+	{0x004A, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'j', 'Ｊ', 'J', 'J', 'J'},                              // 0x004A J
+	{0x004B, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'k', 'Ｋ', 'K', 'K', 'K'},                              // 0x004B K
+	{0x004C, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'l', 'Ｌ', 'L', 'L', 'L'},                              // 0x004C L
+	{0x004D, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'm', 'Ｍ', 'M', 'M', 'M'},                              // 0x004D M
+	{0x004E, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'n', 'Ｎ', 'N', 'N', 'N'},                              // 0x004E N
+	{0x004F, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'o', 'Ｏ', 'O', 'O', 'O'},                              // 0x004F O
+	{0x0050, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'p', 'Ｐ', 'P', 'P', 'P'},                              // 0x0050 P
+	{0x0051, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'q', 'Ｑ', 'Q', 'Q', 'Q'},                              // 0x0051 Q
+	{0x0052, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'r', 'Ｒ', 'R', 'R', 'R'},                              // 0x0052 R
+	{0x0053, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 's', 'Ｓ', 'S', 'S', 'S'},                              // 0x0053 S
+	{0x0054, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 't', 'Ｔ', 'T', 'T', 'T'},                              // 0x0054 T
+	{0x0055, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'u', 'Ｕ', 'U', 'U', 'U'},                              // 0x0055 U
+	{0x0056, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'v', 'Ｖ', 'V', 'V', 'V'},                              // 0x0056 V
+	{0x0057, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'w', 'Ｗ', 'W', 'W', 'W'},                              // 0x0057 W
+	{0x0058, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'x', 'Ｘ', 'X', 'X', 'X'},                              // 0x0058 X
+	{0x0059, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'y', 'Ｙ', 'Y', 'Y', 'Y'},                              // 0x0059 Y
+	{0x005A, ctLatinLetter, ccUpper, cwNarrow, vcUndefined, 'z', 'Ｚ', 'Z', 'Z', 'Z'},                              // 0x005A Z
+	{0x005B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '[', '［', '[', '[', '['},                          // 0x005B [
+	{0x005C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '\\', '＼', '\\', '\\', '\\'},                      // 0x005C \
+	{0x005D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, ']', '］', ']', ']', ']'},                          // 0x005D ]
+	{0x005E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '^', '＾', '^', '^', '^'},                          // 0x005E ^
+	{0x005F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '_', '＿', '_', '_', '_'},                          // 0x005F _
+	{0x0060, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '`', '｀', '`', '`', '`'},                          // 0x0060 `
+	{0x0061, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'A', 'ａ', 'a', 'a', 'a'},                              // 0x0061 a
+	{0x0062, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'B', 'ｂ', 'b', 'b', 'b'},                              // 0x0062 b
+	{0x0063, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'C', 'ｃ', 'c', 'c', 'c'},                              // 0x0063 c
+	{0x0064, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'D', 'ｄ', 'd', 'd', 'd'},                              // 0x0064 d
+	{0x0065, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'E', 'ｅ', 'e', 'e', 'e'},                              // 0x0065 e
+	{0x0066, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'F', 'ｆ', 'f', 'f', 'f'},                              // 0x0066 f
+	{0x0067, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'G', 'ｇ', 'g', 'g', 'g'},                              // 0x0067 g
+	{0x0068, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'H', 'ｈ', 'h', 'h', 'h'},                              // 0x0068 h
+	{0x0069, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'I', 'ｉ', 'i', 'i', 'i'},                              // 0x0069 i
+	{0x006A, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'J', 'ｊ', 'j', 'j', 'j'},                              // 0x006A j
+	{0x006B, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'K', 'ｋ', 'k', 'k', 'k'},                              // 0x006B k
+	{0x006C, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'L', 'ｌ', 'l', 'l', 'l'},                              // 0x006C l
+	{0x006D, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'M', 'ｍ', 'm', 'm', 'm'},                              // 0x006D m
+	{0x006E, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'N', 'ｎ', 'n', 'n', 'n'},                              // 0x006E n
+	{0x006F, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'O', 'ｏ', 'o', 'o', 'o'},                              // 0x006F o
+	{0x0070, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'P', 'ｐ', 'p', 'p', 'p'},                              // 0x0070 p
+	{0x0071, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'Q', 'ｑ', 'q', 'q', 'q'},                              // 0x0071 q
+	{0x0072, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'R', 'ｒ', 'r', 'r', 'r'},                              // 0x0072 r
+	{0x0073, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'S', 'ｓ', 's', 's', 's'},                              // 0x0073 s
+	{0x0074, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'T', 'ｔ', 't', 't', 't'},                              // 0x0074 t
+	{0x0075, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'U', 'ｕ', 'u', 'u', 'u'},                              // 0x0075 u
+	{0x0076, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'V', 'ｖ', 'v', 'v', 'v'},                              // 0x0076 v
+	{0x0077, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'W', 'ｗ', 'w', 'w', 'w'},                              // 0x0077 w
+	{0x0078, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'X', 'ｘ', 'x', 'x', 'x'},                              // 0x0078 x
+	{0x0079, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'Y', 'ｙ', 'y', 'y', 'y'},                              // 0x0079 y
+	{0x007A, ctLatinLetter, ccLower, cwNarrow, vcUndefined, 'Z', 'ｚ', 'z', 'z', 'z'},                              // 0x007A z
+	{0x007B, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '{', '｛', '{', '{', '{'},                          // 0x007B {
+	{0x007C, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '|', '｜', '|', '|', '|'},                          // 0x007C |
+	{0x007D, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '}', '｝', '}', '}', '}'},                          // 0x007D }
+	{0x007E, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '~', '～', '~', '~', '~'},                          // 0x007E ~
+	{0x007F, ctLatinSymbol, ccUndefined, cwNarrow, vcUndefined, '\u007f', '\u007f', '\u007f', '\u007f', '\u007f'}, // 0x007F 
+}
+
+var letterlikeFirst rune = 0x2100
+var letterlikeLast rune = 0x214F
+var letterlikeTable = unichars{
+	{0x2100, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℀', '℀', '℀', '℀', '℀'},    // 0x2100 ℀
+	{0x2101, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℁', '℁', '℁', '℁', '℁'},    // 0x2101 ℁
+	{0x2102, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℂ', 'ℂ', 'ℂ', 'ℂ', 'ℂ'},    // 0x2102 ℂ
+	{0x2103, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℃', '℃', '℃', '℃', '℃'},    // 0x2103 ℃
+	{0x2104, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℄', '℄', '℄', '℄', '℄'}, // 0x2104 ℄
+	{0x2105, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℅', '℅', '℅', '℅', '℅'},    // 0x2105 ℅
+	{0x2106, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℆', '℆', '℆', '℆', '℆'},    // 0x2106 ℆
+	{0x2107, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℇ', 'ℇ', 'ℇ', 'ℇ', 'ℇ'},    // 0x2107 ℇ
+	{0x2108, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℈', '℈', '℈', '℈', '℈'}, // 0x2108 ℈
+	{0x2109, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℉', '℉', '℉', '℉', '℉'},    // 0x2109 ℉
+	{0x210A, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℊ', 'ℊ', 'ℊ', 'ℊ', 'ℊ'},    // 0x210A ℊ
+	{0x210B, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℋ', 'ℋ', 'ℋ', 'ℋ', 'ℋ'},    // 0x210B ℋ
+	{0x210C, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℌ', 'ℌ', 'ℌ', 'ℌ', 'ℌ'},    // 0x210C ℌ
+	{0x210D, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℍ', 'ℍ', 'ℍ', 'ℍ', 'ℍ'},    // 0x210D ℍ
+	{0x210E, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℎ', 'ℎ', 'ℎ', 'ℎ', 'ℎ'},    // 0x210E ℎ
+	{0x210F, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℏ', 'ℏ', 'ℏ', 'ℏ', 'ℏ'},    // 0x210F ℏ
+	{0x2110, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℐ', 'ℐ', 'ℐ', 'ℐ', 'ℐ'},    // 0x2110 ℐ
+	{0x2111, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℑ', 'ℑ', 'ℑ', 'ℑ', 'ℑ'},    // 0x2111 ℑ
+	{0x2112, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℒ', 'ℒ', 'ℒ', 'ℒ', 'ℒ'},    // 0x2112 ℒ
+	{0x2113, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℓ', 'ℓ', 'ℓ', 'ℓ', 'ℓ'},    // 0x2113 ℓ
+	{0x2114, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℔', '℔', '℔', '℔', '℔'}, // 0x2114 ℔
+	{0x2115, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℕ', 'ℕ', 'ℕ', 'ℕ', 'ℕ'},    // 0x2115 ℕ
+	{0x2116, ctCompat, ccUndefined, cwUndefined, vcUndefined, '№', '№', '№', '№', '№'},    // 0x2116 №
+	{0x2117, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℗', '℗', '℗', '℗', '℗'}, // 0x2117 ℗
+	{0x2118, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℘', '℘', '℘', '℘', '℘'}, // 0x2118 ℘
+	{0x2119, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℙ', 'ℙ', 'ℙ', 'ℙ', 'ℙ'},    // 0x2119 ℙ
+	{0x211A, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℚ', 'ℚ', 'ℚ', 'ℚ', 'ℚ'},    // 0x211A ℚ
+	{0x211B, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℛ', 'ℛ', 'ℛ', 'ℛ', 'ℛ'},    // 0x211B ℛ
+	{0x211C, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℜ', 'ℜ', 'ℜ', 'ℜ', 'ℜ'},    // 0x211C ℜ
+	{0x211D, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℝ', 'ℝ', 'ℝ', 'ℝ', 'ℝ'},    // 0x211D ℝ
+	{0x211E, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℞', '℞', '℞', '℞', '℞'}, // 0x211E ℞
+	{0x211F, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℟', '℟', '℟', '℟', '℟'}, // 0x211F ℟
+	{0x2120, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℠', '℠', '℠', '℠', '℠'},    // 0x2120 ℠
+	{0x2121, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℡', '℡', '℡', '℡', '℡'},    // 0x2121 ℡
+	{0x2122, ctCompat, ccUndefined, cwUndefined, vcUndefined, '™', '™', '™', '™', '™'},    // 0x2122 ™
+	{0x2123, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℣', '℣', '℣', '℣', '℣'}, // 0x2123 ℣
+	{0x2124, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℤ', 'ℤ', 'ℤ', 'ℤ', 'ℤ'},    // 0x2124 ℤ
+	{0x2125, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℥', '℥', '℥', '℥', '℥'}, // 0x2125 ℥
+	{0x2126, ctUndefined, ccUndefined, cwUndefined, vcUndefined, 'Ω', 'Ω', 'Ω', 'Ω', 'Ω'}, // 0x2126 Ω
+	{0x2127, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℧', '℧', '℧', '℧', '℧'}, // 0x2127 ℧
+	{0x2128, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℨ', 'ℨ', 'ℨ', 'ℨ', 'ℨ'},    // 0x2128 ℨ
+	{0x2129, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℩', '℩', '℩', '℩', '℩'}, // 0x2129 ℩
+	{0x212A, ctUndefined, ccUndefined, cwUndefined, vcUndefined, 'K', 'K', 'K', 'K', 'K'}, // 0x212A K
+	{0x212B, ctUndefined, ccUndefined, cwUndefined, vcUndefined, 'Å', 'Å', 'Å', 'Å', 'Å'}, // 0x212B Å
+	{0x212C, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℬ', 'ℬ', 'ℬ', 'ℬ', 'ℬ'},    // 0x212C ℬ
+	{0x212D, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℭ', 'ℭ', 'ℭ', 'ℭ', 'ℭ'},    // 0x212D ℭ
+	{0x212E, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℮', '℮', '℮', '℮', '℮'}, // 0x212E ℮
+	{0x212F, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℯ', 'ℯ', 'ℯ', 'ℯ', 'ℯ'},    // 0x212F ℯ
+	{0x2130, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℰ', 'ℰ', 'ℰ', 'ℰ', 'ℰ'},    // 0x2130 ℰ
+	{0x2131, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℱ', 'ℱ', 'ℱ', 'ℱ', 'ℱ'},    // 0x2131 ℱ
+	{0x2132, ctUndefined, ccUndefined, cwUndefined, vcUndefined, 'Ⅎ', 'Ⅎ', 'Ⅎ', 'Ⅎ', 'Ⅎ'}, // 0x2132 Ⅎ
+	{0x2133, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℳ', 'ℳ', 'ℳ', 'ℳ', 'ℳ'},    // 0x2133 ℳ
+	{0x2134, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℴ', 'ℴ', 'ℴ', 'ℴ', 'ℴ'},    // 0x2134 ℴ
+	{0x2135, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℵ', 'ℵ', 'ℵ', 'ℵ', 'ℵ'},    // 0x2135 ℵ
+	{0x2136, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℶ', 'ℶ', 'ℶ', 'ℶ', 'ℶ'},    // 0x2136 ℶ
+	{0x2137, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℷ', 'ℷ', 'ℷ', 'ℷ', 'ℷ'},    // 0x2137 ℷ
+	{0x2138, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℸ', 'ℸ', 'ℸ', 'ℸ', 'ℸ'},    // 0x2138 ℸ
+	{0x2139, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℹ', 'ℹ', 'ℹ', 'ℹ', 'ℹ'},    // 0x2139 ℹ
+	{0x213A, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '℺', '℺', '℺', '℺', '℺'}, // 0x213A ℺
+	{0x213B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '℻', '℻', '℻', '℻', '℻'},    // 0x213B ℻
+	{0x213C, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℼ', 'ℼ', 'ℼ', 'ℼ', 'ℼ'},    // 0x213C ℼ
+	{0x213D, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℽ', 'ℽ', 'ℽ', 'ℽ', 'ℽ'},    // 0x213D ℽ
+	{0x213E, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℾ', 'ℾ', 'ℾ', 'ℾ', 'ℾ'},    // 0x213E ℾ
+	{0x213F, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ℿ', 'ℿ', 'ℿ', 'ℿ', 'ℿ'},    // 0x213F ℿ
+	{0x2140, ctCompat, ccUndefined, cwUndefined, vcUndefined, '⅀', '⅀', '⅀', '⅀', '⅀'},    // 0x2140 ⅀
+	{0x2141, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅁', '⅁', '⅁', '⅁', '⅁'}, // 0x2141 ⅁
+	{0x2142, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅂', '⅂', '⅂', '⅂', '⅂'}, // 0x2142 ⅂
+	{0x2143, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅃', '⅃', '⅃', '⅃', '⅃'}, // 0x2143 ⅃
+	{0x2144, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅄', '⅄', '⅄', '⅄', '⅄'}, // 0x2144 ⅄
+	{0x2145, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ⅅ', 'ⅅ', 'ⅅ', 'ⅅ', 'ⅅ'},    // 0x2145 ⅅ
+	{0x2146, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ⅆ', 'ⅆ', 'ⅆ', 'ⅆ', 'ⅆ'},    // 0x2146 ⅆ
+	{0x2147, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ⅇ', 'ⅇ', 'ⅇ', 'ⅇ', 'ⅇ'},    // 0x2147 ⅇ
+	{0x2148, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ⅈ', 'ⅈ', 'ⅈ', 'ⅈ', 'ⅈ'},    // 0x2148 ⅈ
+	{0x2149, ctCompat, ccUndefined, cwUndefined, vcUndefined, 'ⅉ', 'ⅉ', 'ⅉ', 'ⅉ', 'ⅉ'},    // 0x2149 ⅉ
+	{0x214A, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅊', '⅊', '⅊', '⅊', '⅊'}, // 0x214A ⅊
+	{0x214B, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅋', '⅋', '⅋', '⅋', '⅋'}, // 0x214B ⅋
+	{0x214C, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅌', '⅌', '⅌', '⅌', '⅌'}, // 0x214C ⅌
+	{0x214D, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅍', '⅍', '⅍', '⅍', '⅍'}, // 0x214D ⅍
+	{0x214E, ctUndefined, ccUndefined, cwUndefined, vcUndefined, 'ⅎ', 'ⅎ', 'ⅎ', 'ⅎ', 'ⅎ'}, // 0x214E ⅎ
+	{0x214F, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⅏', '⅏', '⅏', '⅏', '⅏'}, // 0x214F ⅏
+}
+
+var enclosedFirst rune = 0x2460
+var enclosedLast rune = 0x24FF
+var enclosedTable = unichars{
+	{0x2460, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '①', '①', '①', '①', '①'},  // 0x2460 ①
+	{0x2461, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '②', '②', '②', '②', '②'},  // 0x2461 ②
+	{0x2462, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '③', '③', '③', '③', '③'},  // 0x2462 ③
+	{0x2463, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '④', '④', '④', '④', '④'},  // 0x2463 ④
+	{0x2464, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑤', '⑤', '⑤', '⑤', '⑤'},  // 0x2464 ⑤
+	{0x2465, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑥', '⑥', '⑥', '⑥', '⑥'},  // 0x2465 ⑥
+	{0x2466, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑦', '⑦', '⑦', '⑦', '⑦'},  // 0x2466 ⑦
+	{0x2467, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑧', '⑧', '⑧', '⑧', '⑧'},  // 0x2467 ⑧
+	{0x2468, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑨', '⑨', '⑨', '⑨', '⑨'},  // 0x2468 ⑨
+	{0x2469, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑩', '⑩', '⑩', '⑩', '⑩'},  // 0x2469 ⑩
+	{0x246A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑪', '⑪', '⑪', '⑪', '⑪'},  // 0x246A ⑪
+	{0x246B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑫', '⑫', '⑫', '⑫', '⑫'},  // 0x246B ⑫
+	{0x246C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑬', '⑬', '⑬', '⑬', '⑬'},  // 0x246C ⑬
+	{0x246D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑭', '⑭', '⑭', '⑭', '⑭'},  // 0x246D ⑭
+	{0x246E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑮', '⑮', '⑮', '⑮', '⑮'},  // 0x246E ⑮
+	{0x246F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑯', '⑯', '⑯', '⑯', '⑯'},  // 0x246F ⑯
+	{0x2470, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑰', '⑰', '⑰', '⑰', '⑰'},  // 0x2470 ⑰
+	{0x2471, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑱', '⑱', '⑱', '⑱', '⑱'},  // 0x2471 ⑱
+	{0x2472, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑲', '⑲', '⑲', '⑲', '⑲'},  // 0x2472 ⑲
+	{0x2473, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑳', '⑳', '⑳', '⑳', '⑳'},  // 0x2473 ⑳
+	{0x2474, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑴', '⑴', '⑴', '⑴', '⑴'},  // 0x2474 ⑴
+	{0x2475, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑵', '⑵', '⑵', '⑵', '⑵'},  // 0x2475 ⑵
+	{0x2476, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑶', '⑶', '⑶', '⑶', '⑶'},  // 0x2476 ⑶
+	{0x2477, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑷', '⑷', '⑷', '⑷', '⑷'},  // 0x2477 ⑷
+	{0x2478, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑸', '⑸', '⑸', '⑸', '⑸'},  // 0x2478 ⑸
+	{0x2479, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑹', '⑹', '⑹', '⑹', '⑹'},  // 0x2479 ⑹
+	{0x247A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑺', '⑺', '⑺', '⑺', '⑺'},  // 0x247A ⑺
+	{0x247B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑻', '⑻', '⑻', '⑻', '⑻'},  // 0x247B ⑻
+	{0x247C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑼', '⑼', '⑼', '⑼', '⑼'},  // 0x247C ⑼
+	{0x247D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑽', '⑽', '⑽', '⑽', '⑽'},  // 0x247D ⑽
+	{0x247E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑾', '⑾', '⑾', '⑾', '⑾'},  // 0x247E ⑾
+	{0x247F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⑿', '⑿', '⑿', '⑿', '⑿'},  // 0x247F ⑿
+	{0x2480, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒀', '⒀', '⒀', '⒀', '⒀'},  // 0x2480 ⒀
+	{0x2481, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒁', '⒁', '⒁', '⒁', '⒁'},  // 0x2481 ⒁
+	{0x2482, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒂', '⒂', '⒂', '⒂', '⒂'},  // 0x2482 ⒂
+	{0x2483, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒃', '⒃', '⒃', '⒃', '⒃'},  // 0x2483 ⒃
+	{0x2484, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒄', '⒄', '⒄', '⒄', '⒄'},  // 0x2484 ⒄
+	{0x2485, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒅', '⒅', '⒅', '⒅', '⒅'},  // 0x2485 ⒅
+	{0x2486, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒆', '⒆', '⒆', '⒆', '⒆'},  // 0x2486 ⒆
+	{0x2487, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒇', '⒇', '⒇', '⒇', '⒇'},  // 0x2487 ⒇
+	{0x2488, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒈', '⒈', '⒈', '⒈', '⒈'},  // 0x2488 ⒈
+	{0x2489, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒉', '⒉', '⒉', '⒉', '⒉'},  // 0x2489 ⒉
+	{0x248A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒊', '⒊', '⒊', '⒊', '⒊'},  // 0x248A ⒊
+	{0x248B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒋', '⒋', '⒋', '⒋', '⒋'},  // 0x248B ⒋
+	{0x248C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒌', '⒌', '⒌', '⒌', '⒌'},  // 0x248C ⒌
+	{0x248D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒍', '⒍', '⒍', '⒍', '⒍'},  // 0x248D ⒍
+	{0x248E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒎', '⒎', '⒎', '⒎', '⒎'},  // 0x248E ⒎
+	{0x248F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒏', '⒏', '⒏', '⒏', '⒏'},  // 0x248F ⒏
+	{0x2490, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒐', '⒐', '⒐', '⒐', '⒐'},  // 0x2490 ⒐
+	{0x2491, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒑', '⒑', '⒑', '⒑', '⒑'},  // 0x2491 ⒑
+	{0x2492, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒒', '⒒', '⒒', '⒒', '⒒'},  // 0x2492 ⒒
+	{0x2493, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒓', '⒓', '⒓', '⒓', '⒓'},  // 0x2493 ⒓
+	{0x2494, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒔', '⒔', '⒔', '⒔', '⒔'},  // 0x2494 ⒔
+	{0x2495, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒕', '⒕', '⒕', '⒕', '⒕'},  // 0x2495 ⒕
+	{0x2496, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒖', '⒖', '⒖', '⒖', '⒖'},  // 0x2496 ⒖
+	{0x2497, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒗', '⒗', '⒗', '⒗', '⒗'},  // 0x2497 ⒗
+	{0x2498, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒘', '⒘', '⒘', '⒘', '⒘'},  // 0x2498 ⒘
+	{0x2499, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒙', '⒙', '⒙', '⒙', '⒙'},  // 0x2499 ⒙
+	{0x249A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒚', '⒚', '⒚', '⒚', '⒚'},  // 0x249A ⒚
+	{0x249B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒛', '⒛', '⒛', '⒛', '⒛'},  // 0x249B ⒛
+	{0x249C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒜', '⒜', '⒜', '⒜', '⒜'},  // 0x249C ⒜
+	{0x249D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒝', '⒝', '⒝', '⒝', '⒝'},  // 0x249D ⒝
+	{0x249E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒞', '⒞', '⒞', '⒞', '⒞'},  // 0x249E ⒞
+	{0x249F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒟', '⒟', '⒟', '⒟', '⒟'},  // 0x249F ⒟
+	{0x24A0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒠', '⒠', '⒠', '⒠', '⒠'},  // 0x24A0 ⒠
+	{0x24A1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒡', '⒡', '⒡', '⒡', '⒡'},  // 0x24A1 ⒡
+	{0x24A2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒢', '⒢', '⒢', '⒢', '⒢'},  // 0x24A2 ⒢
+	{0x24A3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒣', '⒣', '⒣', '⒣', '⒣'},  // 0x24A3 ⒣
+	{0x24A4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒤', '⒤', '⒤', '⒤', '⒤'},  // 0x24A4 ⒤
+	{0x24A5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒥', '⒥', '⒥', '⒥', '⒥'},  // 0x24A5 ⒥
+	{0x24A6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒦', '⒦', '⒦', '⒦', '⒦'},  // 0x24A6 ⒦
+	{0x24A7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒧', '⒧', '⒧', '⒧', '⒧'},  // 0x24A7 ⒧
+	{0x24A8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒨', '⒨', '⒨', '⒨', '⒨'},  // 0x24A8 ⒨
+	{0x24A9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒩', '⒩', '⒩', '⒩', '⒩'},  // 0x24A9 ⒩
+	{0x24AA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒪', '⒪', '⒪', '⒪', '⒪'},  // 0x24AA ⒪
+	{0x24AB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒫', '⒫', '⒫', '⒫', '⒫'},  // 0x24AB ⒫
+	{0x24AC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒬', '⒬', '⒬', '⒬', '⒬'},  // 0x24AC ⒬
+	{0x24AD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒭', '⒭', '⒭', '⒭', '⒭'},  // 0x24AD ⒭
+	{0x24AE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒮', '⒮', '⒮', '⒮', '⒮'},  // 0x24AE ⒮
+	{0x24AF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒯', '⒯', '⒯', '⒯', '⒯'},  // 0x24AF ⒯
+	{0x24B0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒰', '⒰', '⒰', '⒰', '⒰'},  // 0x24B0 ⒰
+	{0x24B1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒱', '⒱', '⒱', '⒱', '⒱'},  // 0x24B1 ⒱
+	{0x24B2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒲', '⒲', '⒲', '⒲', '⒲'},  // 0x24B2 ⒲
+	{0x24B3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒳', '⒳', '⒳', '⒳', '⒳'},  // 0x24B3 ⒳
+	{0x24B4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒴', '⒴', '⒴', '⒴', '⒴'},  // 0x24B4 ⒴
+	{0x24B5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⒵', '⒵', '⒵', '⒵', '⒵'},  // 0x24B5 ⒵
+	{0x24B6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓐ', 'Ⓐ', 'Ⓐ', 'Ⓐ', 'Ⓐ'},  // 0x24B6 Ⓐ
+	{0x24B7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓑ', 'Ⓑ', 'Ⓑ', 'Ⓑ', 'Ⓑ'},  // 0x24B7 Ⓑ
+	{0x24B8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓒ', 'Ⓒ', 'Ⓒ', 'Ⓒ', 'Ⓒ'},  // 0x24B8 Ⓒ
+	{0x24B9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓓ', 'Ⓓ', 'Ⓓ', 'Ⓓ', 'Ⓓ'},  // 0x24B9 Ⓓ
+	{0x24BA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓔ', 'Ⓔ', 'Ⓔ', 'Ⓔ', 'Ⓔ'},  // 0x24BA Ⓔ
+	{0x24BB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓕ', 'Ⓕ', 'Ⓕ', 'Ⓕ', 'Ⓕ'},  // 0x24BB Ⓕ
+	{0x24BC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓖ', 'Ⓖ', 'Ⓖ', 'Ⓖ', 'Ⓖ'},  // 0x24BC Ⓖ
+	{0x24BD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓗ', 'Ⓗ', 'Ⓗ', 'Ⓗ', 'Ⓗ'},  // 0x24BD Ⓗ
+	{0x24BE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓘ', 'Ⓘ', 'Ⓘ', 'Ⓘ', 'Ⓘ'},  // 0x24BE Ⓘ
+	{0x24BF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓙ', 'Ⓙ', 'Ⓙ', 'Ⓙ', 'Ⓙ'},  // 0x24BF Ⓙ
+	{0x24C0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓚ', 'Ⓚ', 'Ⓚ', 'Ⓚ', 'Ⓚ'},  // 0x24C0 Ⓚ
+	{0x24C1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓛ', 'Ⓛ', 'Ⓛ', 'Ⓛ', 'Ⓛ'},  // 0x24C1 Ⓛ
+	{0x24C2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓜ', 'Ⓜ', 'Ⓜ', 'Ⓜ', 'Ⓜ'},  // 0x24C2 Ⓜ
+	{0x24C3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓝ', 'Ⓝ', 'Ⓝ', 'Ⓝ', 'Ⓝ'},  // 0x24C3 Ⓝ
+	{0x24C4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓞ', 'Ⓞ', 'Ⓞ', 'Ⓞ', 'Ⓞ'},  // 0x24C4 Ⓞ
+	{0x24C5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓟ', 'Ⓟ', 'Ⓟ', 'Ⓟ', 'Ⓟ'},  // 0x24C5 Ⓟ
+	{0x24C6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓠ', 'Ⓠ', 'Ⓠ', 'Ⓠ', 'Ⓠ'},  // 0x24C6 Ⓠ
+	{0x24C7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓡ', 'Ⓡ', 'Ⓡ', 'Ⓡ', 'Ⓡ'},  // 0x24C7 Ⓡ
+	{0x24C8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓢ', 'Ⓢ', 'Ⓢ', 'Ⓢ', 'Ⓢ'},  // 0x24C8 Ⓢ
+	{0x24C9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓣ', 'Ⓣ', 'Ⓣ', 'Ⓣ', 'Ⓣ'},  // 0x24C9 Ⓣ
+	{0x24CA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓤ', 'Ⓤ', 'Ⓤ', 'Ⓤ', 'Ⓤ'},  // 0x24CA Ⓤ
+	{0x24CB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓥ', 'Ⓥ', 'Ⓥ', 'Ⓥ', 'Ⓥ'},  // 0x24CB Ⓥ
+	{0x24CC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓦ', 'Ⓦ', 'Ⓦ', 'Ⓦ', 'Ⓦ'},  // 0x24CC Ⓦ
+	{0x24CD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓧ', 'Ⓧ', 'Ⓧ', 'Ⓧ', 'Ⓧ'},  // 0x24CD Ⓧ
+	{0x24CE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓨ', 'Ⓨ', 'Ⓨ', 'Ⓨ', 'Ⓨ'},  // 0x24CE Ⓨ
+	{0x24CF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'Ⓩ', 'Ⓩ', 'Ⓩ', 'Ⓩ', 'Ⓩ'},  // 0x24CF Ⓩ
+	{0x24D0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓐ', 'ⓐ', 'ⓐ', 'ⓐ', 'ⓐ'},  // 0x24D0 ⓐ
+	{0x24D1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓑ', 'ⓑ', 'ⓑ', 'ⓑ', 'ⓑ'},  // 0x24D1 ⓑ
+	{0x24D2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓒ', 'ⓒ', 'ⓒ', 'ⓒ', 'ⓒ'},  // 0x24D2 ⓒ
+	{0x24D3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓓ', 'ⓓ', 'ⓓ', 'ⓓ', 'ⓓ'},  // 0x24D3 ⓓ
+	{0x24D4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓔ', 'ⓔ', 'ⓔ', 'ⓔ', 'ⓔ'},  // 0x24D4 ⓔ
+	{0x24D5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓕ', 'ⓕ', 'ⓕ', 'ⓕ', 'ⓕ'},  // 0x24D5 ⓕ
+	{0x24D6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓖ', 'ⓖ', 'ⓖ', 'ⓖ', 'ⓖ'},  // 0x24D6 ⓖ
+	{0x24D7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓗ', 'ⓗ', 'ⓗ', 'ⓗ', 'ⓗ'},  // 0x24D7 ⓗ
+	{0x24D8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓘ', 'ⓘ', 'ⓘ', 'ⓘ', 'ⓘ'},  // 0x24D8 ⓘ
+	{0x24D9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓙ', 'ⓙ', 'ⓙ', 'ⓙ', 'ⓙ'},  // 0x24D9 ⓙ
+	{0x24DA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓚ', 'ⓚ', 'ⓚ', 'ⓚ', 'ⓚ'},  // 0x24DA ⓚ
+	{0x24DB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓛ', 'ⓛ', 'ⓛ', 'ⓛ', 'ⓛ'},  // 0x24DB ⓛ
+	{0x24DC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓜ', 'ⓜ', 'ⓜ', 'ⓜ', 'ⓜ'},  // 0x24DC ⓜ
+	{0x24DD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓝ', 'ⓝ', 'ⓝ', 'ⓝ', 'ⓝ'},  // 0x24DD ⓝ
+	{0x24DE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓞ', 'ⓞ', 'ⓞ', 'ⓞ', 'ⓞ'},  // 0x24DE ⓞ
+	{0x24DF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓟ', 'ⓟ', 'ⓟ', 'ⓟ', 'ⓟ'},  // 0x24DF ⓟ
+	{0x24E0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓠ', 'ⓠ', 'ⓠ', 'ⓠ', 'ⓠ'},  // 0x24E0 ⓠ
+	{0x24E1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓡ', 'ⓡ', 'ⓡ', 'ⓡ', 'ⓡ'},  // 0x24E1 ⓡ
+	{0x24E2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓢ', 'ⓢ', 'ⓢ', 'ⓢ', 'ⓢ'},  // 0x24E2 ⓢ
+	{0x24E3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓣ', 'ⓣ', 'ⓣ', 'ⓣ', 'ⓣ'},  // 0x24E3 ⓣ
+	{0x24E4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓤ', 'ⓤ', 'ⓤ', 'ⓤ', 'ⓤ'},  // 0x24E4 ⓤ
+	{0x24E5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓥ', 'ⓥ', 'ⓥ', 'ⓥ', 'ⓥ'},  // 0x24E5 ⓥ
+	{0x24E6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓦ', 'ⓦ', 'ⓦ', 'ⓦ', 'ⓦ'},  // 0x24E6 ⓦ
+	{0x24E7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓧ', 'ⓧ', 'ⓧ', 'ⓧ', 'ⓧ'},  // 0x24E7 ⓧ
+	{0x24E8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓨ', 'ⓨ', 'ⓨ', 'ⓨ', 'ⓨ'},  // 0x24E8 ⓨ
+	{0x24E9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, 'ⓩ', 'ⓩ', 'ⓩ', 'ⓩ', 'ⓩ'},  // 0x24E9 ⓩ
+	{0x24EA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '⓪', '⓪', '⓪', '⓪', '⓪'},  // 0x24EA ⓪
+	{0x24EB, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓫', '⓫', '⓫', '⓫', '⓫'}, // 0x24EB ⓫
+	{0x24EC, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓬', '⓬', '⓬', '⓬', '⓬'}, // 0x24EC ⓬
+	{0x24ED, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓭', '⓭', '⓭', '⓭', '⓭'}, // 0x24ED ⓭
+	{0x24EE, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓮', '⓮', '⓮', '⓮', '⓮'}, // 0x24EE ⓮
+	{0x24EF, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓯', '⓯', '⓯', '⓯', '⓯'}, // 0x24EF ⓯
+	{0x24F0, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓰', '⓰', '⓰', '⓰', '⓰'}, // 0x24F0 ⓰
+	{0x24F1, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓱', '⓱', '⓱', '⓱', '⓱'}, // 0x24F1 ⓱
+	{0x24F2, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓲', '⓲', '⓲', '⓲', '⓲'}, // 0x24F2 ⓲
+	{0x24F3, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓳', '⓳', '⓳', '⓳', '⓳'}, // 0x24F3 ⓳
+	{0x24F4, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓴', '⓴', '⓴', '⓴', '⓴'}, // 0x24F4 ⓴
+	{0x24F5, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓵', '⓵', '⓵', '⓵', '⓵'}, // 0x24F5 ⓵
+	{0x24F6, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓶', '⓶', '⓶', '⓶', '⓶'}, // 0x24F6 ⓶
+	{0x24F7, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓷', '⓷', '⓷', '⓷', '⓷'}, // 0x24F7 ⓷
+	{0x24F8, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓸', '⓸', '⓸', '⓸', '⓸'}, // 0x24F8 ⓸
+	{0x24F9, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓹', '⓹', '⓹', '⓹', '⓹'}, // 0x24F9 ⓹
+	{0x24FA, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓺', '⓺', '⓺', '⓺', '⓺'}, // 0x24FA ⓺
+	{0x24FB, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓻', '⓻', '⓻', '⓻', '⓻'}, // 0x24FB ⓻
+	{0x24FC, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓼', '⓼', '⓼', '⓼', '⓼'}, // 0x24FC ⓼
+	{0x24FD, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓽', '⓽', '⓽', '⓽', '⓽'}, // 0x24FD ⓽
+	{0x24FE, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓾', '⓾', '⓾', '⓾', '⓾'}, // 0x24FE ⓾
+	{0x24FF, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '⓿', '⓿', '⓿', '⓿', '⓿'}, // 0x24FF ⓿
 }
 
 var kanaFirst rune = 0x3000
 var kanaLast rune = 0x30FF
 var kanaTable = unichars{
-	{0x3000, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '\u3000', ' ', '\u3000', '\u3000'},         // 0x3000
-	{0x3001, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '、', '､', '、', '、'},                         // 0x3001 、
-	{0x3002, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '。', '｡', '。', '。'},                         // 0x3002 。
-	{0x3003, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〃', '〃', '〃', '〃'},                         // 0x3003 〃
-	{0x3004, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〄', '〄', '〄', '〄'},                         // 0x3004 〄
-	{0x3005, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '々', '々', '々', '々'},                         // 0x3005 々
-	{0x3006, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〆', '〆', '〆', '〆'},                         // 0x3006 〆
-	{0x3007, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〇', '〇', '〇', '〇'},                         // 0x3007 〇
-	{0x3008, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〈', '〈', '〈', '〈'},                         // 0x3008 〈
-	{0x3009, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〉', '〉', '〉', '〉'},                         // 0x3009 〉
-	{0x300A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '《', '《', '《', '《'},                         // 0x300A 《
-	{0x300B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '》', '》', '》', '》'},                         // 0x300B 》
-	{0x300C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '「', '｢', '「', '「'},                         // 0x300C 「
-	{0x300D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '」', '｣', '」', '」'},                         // 0x300D 」
-	{0x300E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '『', '『', '『', '『'},                         // 0x300E 『
-	{0x300F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '』', '』', '』', '』'},                         // 0x300F 』
-	{0x3010, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '【', '【', '【', '【'},                         // 0x3010 【
-	{0x3011, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '】', '】', '】', '】'},                         // 0x3011 】
-	{0x3012, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〒', '〒', '〒', '〒'},                         // 0x3012 〒
-	{0x3013, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〓', '〓', '〓', '〓'},                         // 0x3013 〓
-	{0x3014, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〔', '〔', '〔', '〔'},                         // 0x3014 〔
-	{0x3015, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〕', '〕', '〕', '〕'},                         // 0x3015 〕
-	{0x3016, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〖', '〖', '〖', '〖'},                         // 0x3016 〖
-	{0x3017, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〗', '〗', '〗', '〗'},                         // 0x3017 〗
-	{0x3018, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〘', '〘', '〘', '〘'},                         // 0x3018 〘
-	{0x3019, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〙', '〙', '〙', '〙'},                         // 0x3019 〙
-	{0x301A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〚', '〚', '〚', '〚'},                         // 0x301A 〚
-	{0x301B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〛', '〛', '〛', '〛'},                         // 0x301B 〛
-	{0x301C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〜', '〜', '〜', '〜'},                         // 0x301C 〜
-	{0x301D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〝', '〝', '〝', '〝'},                         // 0x301D 〝
-	{0x301E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〞', '〞', '〞', '〞'},                         // 0x301E 〞
-	{0x301F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〟', '〟', '〟', '〟'},                         // 0x301F 〟
-	{0x3020, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〠', '〠', '〠', '〠'},                         // 0x3020 〠
-	{0x3021, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〡', '〡', '〡', '〡'},                         // 0x3021 〡
-	{0x3022, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〢', '〢', '〢', '〢'},                         // 0x3022 〢
-	{0x3023, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〣', '〣', '〣', '〣'},                         // 0x3023 〣
-	{0x3024, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〤', '〤', '〤', '〤'},                         // 0x3024 〤
-	{0x3025, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〥', '〥', '〥', '〥'},                         // 0x3025 〥
-	{0x3026, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〦', '〦', '〦', '〦'},                         // 0x3026 〦
-	{0x3027, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〧', '〧', '〧', '〧'},                         // 0x3027 〧
-	{0x3028, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〨', '〨', '〨', '〨'},                         // 0x3028 〨
-	{0x3029, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〩', '〩', '〩', '〩'},                         // 0x3029 〩
-	{0x302A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〪', '〪', '〪', '〪'},                         // 0x302A 〪
-	{0x302B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〫', '〫', '〫', '〫'},                         // 0x302B 〫
-	{0x302C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〬', '〬', '〬', '〬'},                         // 0x302C 〬
-	{0x302D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〭', '〭', '〭', '〭'},                         // 0x302D 〭
-	{0x302E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〮', '〮', '〮', '〮'},                         // 0x302E 〮
-	{0x302F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〯', '〯', '〯', '〯'},                         // 0x302F 〯
-	{0x3030, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〰', '〰', '〰', '〰'},                         // 0x3030 〰
-	{0x3031, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〱', '〱', '〱', '〱'},                         // 0x3031 〱
-	{0x3032, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〲', '〲', '〲', '〲'},                         // 0x3032 〲
-	{0x3033, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〳', '〳', '〳', '〳'},                         // 0x3033 〳
-	{0x3034, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〴', '〴', '〴', '〴'},                         // 0x3034 〴
-	{0x3035, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〵', '〵', '〵', '〵'},                         // 0x3035 〵
-	{0x3036, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〶', '〶', '〶', '〶'},                         // 0x3036 〶
-	{0x3037, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〷', '〷', '〷', '〷'},                         // 0x3037 〷
-	{0x3038, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〸', '〸', '〸', '〸'},                         // 0x3038 〸
-	{0x3039, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〹', '〹', '〹', '〹'},                         // 0x3039 〹
-	{0x303A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〺', '〺', '〺', '〺'},                         // 0x303A 〺
-	{0x303B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〻', '〻', '〻', '〻'},                         // 0x303B 〻
-	{0x303C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〼', '〼', '〼', '〼'},                         // 0x303C 〼
-	{0x303D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〽', '〽', '〽', '〽'},                         // 0x303D 〽
-	{0x303E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〾', '〾', '〾', '〾'},                         // 0x303E 〾
-	{0x303F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〿', '〿', '〿', '〿'},                         // 0x303F 〿
-	{0x3040, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u3040', '\u3040', '\u3040', '\u3040'}, // 0x3040 ぀
-	{0x3041, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ァ', 'ｧ', 'ぁ', 'ぁ'},                          // 0x3041 ぁ
-	{0x3042, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ア', 'ｱ', 'あ', 'あ'},                          // 0x3042 あ
-	{0x3043, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ィ', 'ｨ', 'ぃ', 'ぃ'},                          // 0x3043 ぃ
-	{0x3044, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'イ', 'ｲ', 'い', 'い'},                          // 0x3044 い
-	{0x3045, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ゥ', 'ｩ', 'ぅ', 'ぅ'},                          // 0x3045 ぅ
-	{0x3046, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ウ', 'ｳ', 'ゔ', 'う'},                           // 0x3046 う
-	{0x3047, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ェ', 'ｪ', 'ぇ', 'ぇ'},                          // 0x3047 ぇ
-	{0x3048, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'エ', 'ｴ', 'え', 'え'},                          // 0x3048 え
-	{0x3049, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ォ', 'ｫ', 'ぉ', 'ぉ'},                          // 0x3049 ぉ
-	{0x304A, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'オ', 'ｵ', 'お', 'お'},                          // 0x304A お
-	{0x304B, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'カ', 'ｶ', 'が', 'か'},                           // 0x304B か
-	{0x304C, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ガ', 'ｶ', 'か', 'が'},                             // 0x304C が
-	{0x304D, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'キ', 'ｷ', 'ぎ', 'き'},                           // 0x304D き
-	{0x304E, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ギ', 'ｷ', 'き', 'ぎ'},                             // 0x304E ぎ
-	{0x304F, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ク', 'ｸ', 'ぐ', 'く'},                           // 0x304F く
-	{0x3050, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'グ', 'ｸ', 'く', 'ぐ'},                             // 0x3050 ぐ
-	{0x3051, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ケ', 'ｹ', 'げ', 'け'},                           // 0x3051 け
-	{0x3052, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゲ', 'ｹ', 'け', 'げ'},                             // 0x3052 げ
-	{0x3053, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'コ', 'ｺ', 'ご', 'こ'},                           // 0x3053 こ
-	{0x3054, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゴ', 'ｺ', 'こ', 'ご'},                             // 0x3054 ご
-	{0x3055, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'サ', 'ｻ', 'ざ', 'さ'},                           // 0x3055 さ
-	{0x3056, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ザ', 'ｻ', 'さ', 'ざ'},                             // 0x3056 ざ
-	{0x3057, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'シ', 'ｼ', 'じ', 'し'},                           // 0x3057 し
-	{0x3058, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ジ', 'ｼ', 'し', 'じ'},                             // 0x3058 じ
-	{0x3059, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ス', 'ｽ', 'ず', 'す'},                           // 0x3059 す
-	{0x305A, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ズ', 'ｽ', 'す', 'ず'},                             // 0x305A ず
-	{0x305B, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'セ', 'ｾ', 'ぜ', 'せ'},                           // 0x305B せ
-	{0x305C, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゼ', 'ｾ', 'せ', 'ぜ'},                             // 0x305C ぜ
-	{0x305D, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ソ', 'ｿ', 'ぞ', 'そ'},                           // 0x305D そ
-	{0x305E, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゾ', 'ｿ', 'そ', 'ぞ'},                             // 0x305E ぞ
-	{0x305F, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'タ', 'ﾀ', 'だ', 'た'},                           // 0x305F た
-	{0x3060, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ダ', 'ﾀ', 'た', 'だ'},                             // 0x3060 だ
-	{0x3061, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'チ', 'ﾁ', 'ぢ', 'ち'},                           // 0x3061 ち
-	{0x3062, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ヂ', 'ﾁ', 'ち', 'ぢ'},                             // 0x3062 ぢ
-	{0x3063, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ッ', 'ｯ', 'っ', 'っ'},                          // 0x3063 っ
-	{0x3064, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ツ', 'ﾂ', 'づ', 'つ'},                           // 0x3064 つ
-	{0x3065, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ヅ', 'ﾂ', 'つ', 'づ'},                             // 0x3065 づ
-	{0x3066, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'テ', 'ﾃ', 'で', 'て'},                           // 0x3066 て
-	{0x3067, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'デ', 'ﾃ', 'て', 'で'},                             // 0x3067 で
-	{0x3068, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ト', 'ﾄ', 'ど', 'と'},                           // 0x3068 と
-	{0x3069, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ド', 'ﾄ', 'と', 'ど'},                             // 0x3069 ど
-	{0x306A, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ナ', 'ﾅ', 'な', 'な'},                          // 0x306A な
-	{0x306B, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ニ', 'ﾆ', 'に', 'に'},                          // 0x306B に
-	{0x306C, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヌ', 'ﾇ', 'ぬ', 'ぬ'},                          // 0x306C ぬ
-	{0x306D, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ネ', 'ﾈ', 'ね', 'ね'},                          // 0x306D ね
-	{0x306E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ノ', 'ﾉ', 'の', 'の'},                          // 0x306E の
-	{0x306F, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ハ', 'ﾊ', 'ば', 'ぱ'},                           // 0x306F は
-	{0x3070, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'バ', 'ﾊ', 'は', 'ば'},                             // 0x3070 ば
-	{0x3071, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'パ', 'ﾊ', 'ぱ', 'は'},                         // 0x3071 ぱ
-	{0x3072, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ヒ', 'ﾋ', 'び', 'ぴ'},                           // 0x3072 ひ
-	{0x3073, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ビ', 'ﾋ', 'ひ', 'び'},                             // 0x3073 び
-	{0x3074, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'ピ', 'ﾋ', 'ぴ', 'ひ'},                         // 0x3074 ぴ
-	{0x3075, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'フ', 'ﾌ', 'ぶ', 'ぷ'},                           // 0x3075 ふ
-	{0x3076, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ブ', 'ﾌ', 'ふ', 'ぶ'},                             // 0x3076 ぶ
-	{0x3077, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'プ', 'ﾌ', 'ぷ', 'ふ'},                         // 0x3077 ぷ
-	{0x3078, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ヘ', 'ﾍ', 'べ', 'ぺ'},                           // 0x3078 へ
-	{0x3079, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ベ', 'ﾍ', 'へ', 'べ'},                             // 0x3079 べ
-	{0x307A, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'ペ', 'ﾍ', 'ぺ', 'へ'},                         // 0x307A ぺ
-	{0x307B, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ホ', 'ﾎ', 'ぼ', 'ぽ'},                           // 0x307B ほ
-	{0x307C, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ボ', 'ﾎ', 'ほ', 'ぼ'},                             // 0x307C ぼ
-	{0x307D, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'ポ', 'ﾎ', 'ぽ', 'ほ'},                         // 0x307D ぽ
-	{0x307E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'マ', 'ﾏ', 'ま', 'ま'},                          // 0x307E ま
-	{0x307F, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ミ', 'ﾐ', 'み', 'み'},                          // 0x307F み
-	{0x3080, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ム', 'ﾑ', 'む', 'む'},                          // 0x3080 む
-	{0x3081, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'メ', 'ﾒ', 'め', 'め'},                          // 0x3081 め
-	{0x3082, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'モ', 'ﾓ', 'も', 'も'},                          // 0x3082 も
-	{0x3083, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ャ', 'ｬ', 'ゃ', 'ゃ'},                          // 0x3083 ゃ
-	{0x3084, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヤ', 'ﾔ', 'や', 'や'},                          // 0x3084 や
-	{0x3085, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ュ', 'ｭ', 'ゅ', 'ゅ'},                          // 0x3085 ゅ
-	{0x3086, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ユ', 'ﾕ', 'ゆ', 'ゆ'},                          // 0x3086 ゆ
-	{0x3087, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ョ', 'ｮ', 'ょ', 'ょ'},                          // 0x3087 ょ
-	{0x3088, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヨ', 'ﾖ', 'よ', 'よ'},                          // 0x3088 よ
-	{0x3089, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ラ', 'ﾗ', 'ら', 'ら'},                          // 0x3089 ら
-	{0x308A, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'リ', 'ﾘ', 'り', 'り'},                          // 0x308A り
-	{0x308B, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ル', 'ﾙ', 'る', 'る'},                          // 0x308B る
-	{0x308C, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'レ', 'ﾚ', 'れ', 'れ'},                          // 0x308C れ
-	{0x308D, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ロ', 'ﾛ', 'ろ', 'ろ'},                          // 0x308D ろ
-	{0x308E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヮ', 'ﾜ', 'ゎ', 'ゎ'},                          // 0x308E ゎ
-	{0x308F, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ワ', 'ﾜ', 'わ', 'わ'},                          // 0x308F わ
-	{0x3090, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヰ', 'ｲ', 'ゐ', 'ゐ'},                          // 0x3090 ゐ
-	{0x3091, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヱ', 'ｴ', 'ゑ', 'ゑ'},                          // 0x3091 ゑ
-	{0x3092, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヲ', 'ｦ', 'を', 'を'},                          // 0x3092 を
-	{0x3093, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ン', 'ﾝ', 'ん', 'ん'},                          // 0x3093 ん
-	{0x3094, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ヴ', 'ｳ', 'う', 'ゔ'},                             // 0x3094 ゔ
-	{0x3095, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヵ', 'ｶ', 'ゕ', 'ゕ'},                          // 0x3095 ゕ
-	{0x3096, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヶ', 'ｹ', 'ゖ', 'ゖ'},                          // 0x3096 ゖ
-	{0x3097, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u3097', '\u3097', '\u3097', '\u3097'}, // 0x3097 ゗
-	{0x3098, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u3098', '\u3098', '\u3098', '\u3098'}, // 0x3098 ゘
-	{0x3099, ctKanaVom, ccCombining, cwWide, vcUndefined, '゛', 'ﾞ', '゙', '゙'},                            // 0x3099 ゙
-	{0x309A, ctKanaVom, ccCombining, cwWide, vcUndefined, '゜', 'ﾟ', '゚', '゚'},                            // 0x309A ゚
-	{0x309B, ctKanaVom, ccLegacy, cwWide, vcUndefined, '゙', 'ﾞ', '゛', '゛'},                               // 0x309B ゛
-	{0x309C, ctKanaVom, ccLegacy, cwWide, vcUndefined, '゚', 'ﾟ', '゜', '゜'},                               // 0x309C ゜
-	{0x309D, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヽ', 'ゝ', 'ゝ', 'ゝ'},                          // 0x309D ゝ
-	{0x309E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヾ', 'ゞ', 'ゞ', 'ゞ'},                          // 0x309E ゞ
-	{0x309F, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ゟ', 'ゟ', 'ゟ', 'ゟ'},                          // 0x309F ゟ
-	{0x30A0, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '゠', '゠', '゠', '゠'},                         // 0x30A0 ゠
-	{0x30A1, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぁ', 'ｧ', 'ァ', 'ァ'},                          // 0x30A1 ァ
-	{0x30A2, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'あ', 'ｱ', 'ア', 'ア'},                          // 0x30A2 ア
-	{0x30A3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぃ', 'ｨ', 'ィ', 'ィ'},                          // 0x30A3 ィ
-	{0x30A4, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'い', 'ｲ', 'イ', 'イ'},                          // 0x30A4 イ
-	{0x30A5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぅ', 'ｩ', 'ゥ', 'ゥ'},                          // 0x30A5 ゥ
-	{0x30A6, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'う', 'ｳ', 'ヴ', 'ウ'},                           // 0x30A6 ウ
-	{0x30A7, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぇ', 'ｪ', 'ェ', 'ェ'},                          // 0x30A7 ェ
-	{0x30A8, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'え', 'ｴ', 'エ', 'エ'},                          // 0x30A8 エ
-	{0x30A9, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぉ', 'ｫ', 'ォ', 'ォ'},                          // 0x30A9 ォ
-	{0x30AA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'お', 'ｵ', 'オ', 'オ'},                          // 0x30AA オ
-	{0x30AB, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'か', 'ｶ', 'ガ', 'カ'},                           // 0x30AB カ
-	{0x30AC, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'が', 'ｶ', 'カ', 'ガ'},                             // 0x30AC ガ
-	{0x30AD, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'き', 'ｷ', 'ギ', 'キ'},                           // 0x30AD キ
-	{0x30AE, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぎ', 'ｷ', 'キ', 'ギ'},                             // 0x30AE ギ
-	{0x30AF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'く', 'ｸ', 'グ', 'ク'},                           // 0x30AF ク
-	{0x30B0, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぐ', 'ｸ', 'ク', 'グ'},                             // 0x30B0 グ
-	{0x30B1, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'け', 'ｹ', 'ゲ', 'ケ'},                           // 0x30B1 ケ
-	{0x30B2, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'げ', 'ｹ', 'ケ', 'ゲ'},                             // 0x30B2 ゲ
-	{0x30B3, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'こ', 'ｺ', 'ゴ', 'コ'},                           // 0x30B3 コ
-	{0x30B4, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ご', 'ｺ', 'コ', 'ゴ'},                             // 0x30B4 ゴ
-	{0x30B5, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'さ', 'ｻ', 'ザ', 'サ'},                           // 0x30B5 サ
-	{0x30B6, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ざ', 'ｻ', 'サ', 'ザ'},                             // 0x30B6 ザ
-	{0x30B7, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'し', 'ｼ', 'ジ', 'シ'},                           // 0x30B7 シ
-	{0x30B8, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'じ', 'ｼ', 'シ', 'ジ'},                             // 0x30B8 ジ
-	{0x30B9, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'す', 'ｽ', 'ズ', 'ス'},                           // 0x30B9 ス
-	{0x30BA, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ず', 'ｽ', 'ス', 'ズ'},                             // 0x30BA ズ
-	{0x30BB, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'せ', 'ｾ', 'ゼ', 'セ'},                           // 0x30BB セ
-	{0x30BC, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぜ', 'ｾ', 'セ', 'ゼ'},                             // 0x30BC ゼ
-	{0x30BD, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'そ', 'ｿ', 'ゾ', 'ソ'},                           // 0x30BD ソ
-	{0x30BE, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぞ', 'ｿ', 'ソ', 'ゾ'},                             // 0x30BE ゾ
-	{0x30BF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'た', 'ﾀ', 'ダ', 'タ'},                           // 0x30BF タ
-	{0x30C0, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'だ', 'ﾀ', 'タ', 'ダ'},                             // 0x30C0 ダ
-	{0x30C1, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ち', 'ﾁ', 'ヂ', 'チ'},                           // 0x30C1 チ
-	{0x30C2, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぢ', 'ﾁ', 'チ', 'ヂ'},                             // 0x30C2 ヂ
-	{0x30C3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'っ', 'ｯ', 'ッ', 'ッ'},                          // 0x30C3 ッ
-	{0x30C4, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'つ', 'ﾂ', 'ヅ', 'ツ'},                           // 0x30C4 ツ
-	{0x30C5, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'づ', 'ﾂ', 'ツ', 'ヅ'},                             // 0x30C5 ヅ
-	{0x30C6, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'て', 'ﾃ', 'デ', 'テ'},                           // 0x30C6 テ
-	{0x30C7, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'で', 'ﾃ', 'テ', 'デ'},                             // 0x30C7 デ
-	{0x30C8, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'と', 'ﾄ', 'ド', 'ト'},                           // 0x30C8 ト
-	{0x30C9, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ど', 'ﾄ', 'ト', 'ド'},                             // 0x30C9 ド
-	{0x30CA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'な', 'ﾅ', 'ナ', 'ナ'},                          // 0x30CA ナ
-	{0x30CB, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'に', 'ﾆ', 'ニ', 'ニ'},                          // 0x30CB ニ
-	{0x30CC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぬ', 'ﾇ', 'ヌ', 'ヌ'},                          // 0x30CC ヌ
-	{0x30CD, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ね', 'ﾈ', 'ネ', 'ネ'},                          // 0x30CD ネ
-	{0x30CE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'の', 'ﾉ', 'ノ', 'ノ'},                          // 0x30CE ノ
-	{0x30CF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'は', 'ﾊ', 'バ', 'パ'},                           // 0x30CF ハ
-	{0x30D0, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ば', 'ﾊ', 'ハ', 'バ'},                             // 0x30D0 バ
-	{0x30D1, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぱ', 'ﾊ', 'パ', 'ハ'},                         // 0x30D1 パ
-	{0x30D2, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ひ', 'ﾋ', 'ビ', 'ピ'},                           // 0x30D2 ヒ
-	{0x30D3, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'び', 'ﾋ', 'ヒ', 'ビ'},                             // 0x30D3 ビ
-	{0x30D4, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぴ', 'ﾋ', 'ピ', 'ヒ'},                         // 0x30D4 ピ
-	{0x30D5, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ふ', 'ﾌ', 'ブ', 'プ'},                           // 0x30D5 フ
-	{0x30D6, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぶ', 'ﾌ', 'フ', 'ブ'},                             // 0x30D6 ブ
-	{0x30D7, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぷ', 'ﾌ', 'プ', 'フ'},                         // 0x30D7 プ
-	{0x30D8, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'へ', 'ﾍ', 'ベ', 'ペ'},                           // 0x30D8 ヘ
-	{0x30D9, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'べ', 'ﾍ', 'ヘ', 'ベ'},                             // 0x30D9 ベ
-	{0x30DA, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぺ', 'ﾍ', 'ペ', 'ヘ'},                         // 0x30DA ペ
-	{0x30DB, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ほ', 'ﾎ', 'ボ', 'ポ'},                           // 0x30DB ホ
-	{0x30DC, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぼ', 'ﾎ', 'ホ', 'ボ'},                             // 0x30DC ボ
-	{0x30DD, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぽ', 'ﾎ', 'ポ', 'ホ'},                         // 0x30DD ポ
-	{0x30DE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ま', 'ﾏ', 'マ', 'マ'},                          // 0x30DE マ
-	{0x30DF, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'み', 'ﾐ', 'ミ', 'ミ'},                          // 0x30DF ミ
-	{0x30E0, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'む', 'ﾑ', 'ム', 'ム'},                          // 0x30E0 ム
-	{0x30E1, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'め', 'ﾒ', 'メ', 'メ'},                          // 0x30E1 メ
-	{0x30E2, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'も', 'ﾓ', 'モ', 'モ'},                          // 0x30E2 モ
-	{0x30E3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゃ', 'ｬ', 'ャ', 'ャ'},                          // 0x30E3 ャ
-	{0x30E4, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'や', 'ﾔ', 'ヤ', 'ヤ'},                          // 0x30E4 ヤ
-	{0x30E5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゅ', 'ｭ', 'ュ', 'ュ'},                          // 0x30E5 ュ
-	{0x30E6, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゆ', 'ﾕ', 'ユ', 'ユ'},                          // 0x30E6 ユ
-	{0x30E7, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ょ', 'ｮ', 'ョ', 'ョ'},                          // 0x30E7 ョ
-	{0x30E8, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'よ', 'ﾖ', 'ヨ', 'ヨ'},                          // 0x30E8 ヨ
-	{0x30E9, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ら', 'ﾗ', 'ラ', 'ラ'},                          // 0x30E9 ラ
-	{0x30EA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'り', 'ﾘ', 'リ', 'リ'},                          // 0x30EA リ
-	{0x30EB, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'る', 'ﾙ', 'ル', 'ル'},                          // 0x30EB ル
-	{0x30EC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'れ', 'ﾚ', 'レ', 'レ'},                          // 0x30EC レ
-	{0x30ED, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ろ', 'ﾛ', 'ロ', 'ロ'},                          // 0x30ED ロ
-	{0x30EE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゎ', 'ﾜ', 'ヮ', 'ヮ'},                          // 0x30EE ヮ
-	{0x30EF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'わ', 'ﾜ', 'ヷ', 'ワ'},                           // 0x30EF ワ
-	{0x30F0, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ゐ', 'ｲ', 'ヸ', 'ヰ'},                           // 0x30F0 ヰ
-	{0x30F1, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ゑ', 'ｴ', 'ヹ', 'ヱ'},                           // 0x30F1 ヱ
-	{0x30F2, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'を', 'ｦ', 'ヺ', 'ヲ'},                           // 0x30F2 ヲ
-	{0x30F3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ん', 'ﾝ', 'ン', 'ン'},                          // 0x30F3 ン
-	{0x30F4, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ゔ', 'ｳ', 'ウ', 'ヴ'},                             // 0x30F4 ヴ
-	{0x30F5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゕ', 'ｶ', 'ヵ', 'ヵ'},                          // 0x30F5 ヵ
-	{0x30F6, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゖ', 'ｹ', 'ヶ', 'ヶ'},                          // 0x30F6 ヶ
-	{0x30F7, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'わ', 'ﾜ', 'ワ', 'ヷ'},                             // 0x30F7 ヷ
-	{0x30F8, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ゐ', 'ｲ', 'ヰ', 'ヸ'},                             // 0x30F8 ヸ
-	{0x30F9, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ゑ', 'ｴ', 'ヱ', 'ヹ'},                             // 0x30F9 ヹ
-	{0x30FA, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'を', 'ｦ', 'ヲ', 'ヺ'},                             // 0x30FA ヺ
-	{0x30FB, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '・', '･', '・', '・'},                         // 0x30FB ・
-	{0x30FC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ー', 'ｰ', 'ー', 'ー'},                          // 0x30FC ー
-	{0x30FD, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゝ', 'ヽ', 'ヽ', 'ヽ'},                          // 0x30FD ヽ
-	{0x30FE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゞ', 'ヾ', 'ヾ', 'ヾ'},                          // 0x30FE ヾ
-	{0x30FF, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ヿ', 'ヿ', 'ヿ', 'ヿ'},                          // 0x30FF ヿ
+	{0x3000, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '\u3000', ' ', '\u3000', '\u3000', '\u3000'},         // 0x3000
+	{0x3001, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '、', '､', '、', '、', '、'},                              // 0x3001 、
+	{0x3002, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '。', '｡', '。', '。', '。'},                              // 0x3002 。
+	{0x3003, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〃', '〃', '〃', '〃', '〃'},                              // 0x3003 〃
+	{0x3004, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〄', '〄', '〄', '〄', '〄'},                              // 0x3004 〄
+	{0x3005, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '々', '々', '々', '々', '々'},                              // 0x3005 々
+	{0x3006, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〆', '〆', '〆', '〆', '〆'},                              // 0x3006 〆
+	{0x3007, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〇', '〇', '〇', '〇', '〇'},                              // 0x3007 〇
+	{0x3008, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〈', '〈', '〈', '〈', '〈'},                              // 0x3008 〈
+	{0x3009, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〉', '〉', '〉', '〉', '〉'},                              // 0x3009 〉
+	{0x300A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '《', '《', '《', '《', '《'},                              // 0x300A 《
+	{0x300B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '》', '》', '》', '》', '》'},                              // 0x300B 》
+	{0x300C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '「', '｢', '「', '「', '「'},                              // 0x300C 「
+	{0x300D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '」', '｣', '」', '」', '」'},                              // 0x300D 」
+	{0x300E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '『', '『', '『', '『', '『'},                              // 0x300E 『
+	{0x300F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '』', '』', '』', '』', '』'},                              // 0x300F 』
+	{0x3010, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '【', '【', '【', '【', '【'},                              // 0x3010 【
+	{0x3011, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '】', '】', '】', '】', '】'},                              // 0x3011 】
+	{0x3012, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〒', '〒', '〒', '〒', '〒'},                              // 0x3012 〒
+	{0x3013, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〓', '〓', '〓', '〓', '〓'},                              // 0x3013 〓
+	{0x3014, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〔', '〔', '〔', '〔', '〔'},                              // 0x3014 〔
+	{0x3015, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〕', '〕', '〕', '〕', '〕'},                              // 0x3015 〕
+	{0x3016, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〖', '〖', '〖', '〖', '〖'},                              // 0x3016 〖
+	{0x3017, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〗', '〗', '〗', '〗', '〗'},                              // 0x3017 〗
+	{0x3018, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〘', '〘', '〘', '〘', '〘'},                              // 0x3018 〘
+	{0x3019, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〙', '〙', '〙', '〙', '〙'},                              // 0x3019 〙
+	{0x301A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〚', '〚', '〚', '〚', '〚'},                              // 0x301A 〚
+	{0x301B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〛', '〛', '〛', '〛', '〛'},                              // 0x301B 〛
+	{0x301C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〜', '〜', '〜', '〜', '〜'},                              // 0x301C 〜
+	{0x301D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〝', '〝', '〝', '〝', '〝'},                              // 0x301D 〝
+	{0x301E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〞', '〞', '〞', '〞', '〞'},                              // 0x301E 〞
+	{0x301F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〟', '〟', '〟', '〟', '〟'},                              // 0x301F 〟
+	{0x3020, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〠', '〠', '〠', '〠', '〠'},                              // 0x3020 〠
+	{0x3021, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〡', '〡', '〡', '〡', '〡'},                              // 0x3021 〡
+	{0x3022, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〢', '〢', '〢', '〢', '〢'},                              // 0x3022 〢
+	{0x3023, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〣', '〣', '〣', '〣', '〣'},                              // 0x3023 〣
+	{0x3024, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〤', '〤', '〤', '〤', '〤'},                              // 0x3024 〤
+	{0x3025, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〥', '〥', '〥', '〥', '〥'},                              // 0x3025 〥
+	{0x3026, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〦', '〦', '〦', '〦', '〦'},                              // 0x3026 〦
+	{0x3027, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〧', '〧', '〧', '〧', '〧'},                              // 0x3027 〧
+	{0x3028, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〨', '〨', '〨', '〨', '〨'},                              // 0x3028 〨
+	{0x3029, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〩', '〩', '〩', '〩', '〩'},                              // 0x3029 〩
+	{0x302A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〪', '〪', '〪', '〪', '〪'},                              // 0x302A 〪
+	{0x302B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〫', '〫', '〫', '〫', '〫'},                              // 0x302B 〫
+	{0x302C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〬', '〬', '〬', '〬', '〬'},                              // 0x302C 〬
+	{0x302D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〭', '〭', '〭', '〭', '〭'},                              // 0x302D 〭
+	{0x302E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〮', '〮', '〮', '〮', '〮'},                              // 0x302E 〮
+	{0x302F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〯', '〯', '〯', '〯', '〯'},                              // 0x302F 〯
+	{0x3030, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〰', '〰', '〰', '〰', '〰'},                              // 0x3030 〰
+	{0x3031, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〱', '〱', '〱', '〱', '〱'},                              // 0x3031 〱
+	{0x3032, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〲', '〲', '〲', '〲', '〲'},                              // 0x3032 〲
+	{0x3033, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〳', '〳', '〳', '〳', '〳'},                              // 0x3033 〳
+	{0x3034, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〴', '〴', '〴', '〴', '〴'},                              // 0x3034 〴
+	{0x3035, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〵', '〵', '〵', '〵', '〵'},                              // 0x3035 〵
+	{0x3036, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〶', '〶', '〶', '〶', '〶'},                              // 0x3036 〶
+	{0x3037, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〷', '〷', '〷', '〷', '〷'},                              // 0x3037 〷
+	{0x3038, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〸', '〸', '〸', '〸', '〸'},                              // 0x3038 〸
+	{0x3039, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〹', '〹', '〹', '〹', '〹'},                              // 0x3039 〹
+	{0x303A, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〺', '〺', '〺', '〺', '〺'},                              // 0x303A 〺
+	{0x303B, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〻', '〻', '〻', '〻', '〻'},                              // 0x303B 〻
+	{0x303C, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〼', '〼', '〼', '〼', '〼'},                              // 0x303C 〼
+	{0x303D, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〽', '〽', '〽', '〽', '〽'},                              // 0x303D 〽
+	{0x303E, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〾', '〾', '〾', '〾', '〾'},                              // 0x303E 〾
+	{0x303F, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '〿', '〿', '〿', '〿', '〿'},                              // 0x303F 〿
+	{0x3040, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u3040', '\u3040', '\u3040', '\u3040', '\u3040'}, // 0x3040 ぀
+	{0x3041, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ァ', 'ｧ', 'ぁ', 'ぁ', 'あ'},                               // 0x3041 ぁ
+	{0x3042, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ア', 'ｱ', 'あ', 'あ', 'あ'},                               // 0x3042 あ
+	{0x3043, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ィ', 'ｨ', 'ぃ', 'ぃ', 'い'},                               // 0x3043 ぃ
+	{0x3044, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'イ', 'ｲ', 'い', 'い', 'い'},                               // 0x3044 い
+	{0x3045, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ゥ', 'ｩ', 'ぅ', 'ぅ', 'う'},                               // 0x3045 ぅ
+	{0x3046, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ウ', 'ｳ', 'ゔ', 'う', 'う'},                                // 0x3046 う
+	{0x3047, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ェ', 'ｪ', 'ぇ', 'ぇ', 'え'},                               // 0x3047 ぇ
+	{0x3048, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'エ', 'ｴ', 'え', 'え', 'え'},                               // 0x3048 え
+	{0x3049, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ォ', 'ｫ', 'ぉ', 'ぉ', 'お'},                               // 0x3049 ぉ
+	{0x304A, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'オ', 'ｵ', 'お', 'お', 'お'},                               // 0x304A お
+	{0x304B, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'カ', 'ｶ', 'が', 'か', 'か'},                                // 0x304B か
+	{0x304C, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ガ', 'ｶ', 'か', 'が', 'が'},                                  // 0x304C が
+	{0x304D, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'キ', 'ｷ', 'ぎ', 'き', 'き'},                                // 0x304D き
+	{0x304E, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ギ', 'ｷ', 'き', 'ぎ', 'ぎ'},                                  // 0x304E ぎ
+	{0x304F, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ク', 'ｸ', 'ぐ', 'く', 'く'},                                // 0x304F く
+	{0x3050, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'グ', 'ｸ', 'く', 'ぐ', 'ぐ'},                                  // 0x3050 ぐ
+	{0x3051, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ケ', 'ｹ', 'げ', 'け', 'け'},                                // 0x3051 け
+	{0x3052, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゲ', 'ｹ', 'け', 'げ', 'げ'},                                  // 0x3052 げ
+	{0x3053, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'コ', 'ｺ', 'ご', 'こ', 'こ'},                                // 0x3053 こ
+	{0x3054, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゴ', 'ｺ', 'こ', 'ご', 'ご'},                                  // 0x3054 ご
+	{0x3055, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'サ', 'ｻ', 'ざ', 'さ', 'さ'},                                // 0x3055 さ
+	{0x3056, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ザ', 'ｻ', 'さ', 'ざ', 'ざ'},                                  // 0x3056 ざ
+	{0x3057, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'シ', 'ｼ', 'じ', 'し', 'し'},                                // 0x3057 し
+	{0x3058, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ジ', 'ｼ', 'し', 'じ', 'じ'},                                  // 0x3058 じ
+	{0x3059, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ス', 'ｽ', 'ず', 'す', 'す'},                                // 0x3059 す
+	{0x305A, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ズ', 'ｽ', 'す', 'ず', 'ず'},                                  // 0x305A ず
+	{0x305B, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'セ', 'ｾ', 'ぜ', 'せ', 'せ'},                                // 0x305B せ
+	{0x305C, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゼ', 'ｾ', 'せ', 'ぜ', 'ぜ'},                                  // 0x305C ぜ
+	{0x305D, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ソ', 'ｿ', 'ぞ', 'そ', 'そ'},                                // 0x305D そ
+	{0x305E, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ゾ', 'ｿ', 'そ', 'ぞ', 'ぞ'},                                  // 0x305E ぞ
+	{0x305F, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'タ', 'ﾀ', 'だ', 'た', 'た'},                                // 0x305F た
+	{0x3060, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ダ', 'ﾀ', 'た', 'だ', 'だ'},                                  // 0x3060 だ
+	{0x3061, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'チ', 'ﾁ', 'ぢ', 'ち', 'ち'},                                // 0x3061 ち
+	{0x3062, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ヂ', 'ﾁ', 'ち', 'ぢ', 'ぢ'},                                  // 0x3062 ぢ
+	{0x3063, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ッ', 'ｯ', 'っ', 'っ', 'つ'},                               // 0x3063 っ
+	{0x3064, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ツ', 'ﾂ', 'づ', 'つ', 'つ'},                                // 0x3064 つ
+	{0x3065, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ヅ', 'ﾂ', 'つ', 'づ', 'づ'},                                  // 0x3065 づ
+	{0x3066, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'テ', 'ﾃ', 'で', 'て', 'て'},                                // 0x3066 て
+	{0x3067, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'デ', 'ﾃ', 'て', 'で', 'で'},                                  // 0x3067 で
+	{0x3068, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ト', 'ﾄ', 'ど', 'と', 'と'},                                // 0x3068 と
+	{0x3069, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ド', 'ﾄ', 'と', 'ど', 'ど'},                                  // 0x3069 ど
+	{0x306A, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ナ', 'ﾅ', 'な', 'な', 'な'},                               // 0x306A な
+	{0x306B, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ニ', 'ﾆ', 'に', 'に', 'に'},                               // 0x306B に
+	{0x306C, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヌ', 'ﾇ', 'ぬ', 'ぬ', 'ぬ'},                               // 0x306C ぬ
+	{0x306D, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ネ', 'ﾈ', 'ね', 'ね', 'ね'},                               // 0x306D ね
+	{0x306E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ノ', 'ﾉ', 'の', 'の', 'の'},                               // 0x306E の
+	{0x306F, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ハ', 'ﾊ', 'ば', 'ぱ', 'は'},                                // 0x306F は
+	{0x3070, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'バ', 'ﾊ', 'は', 'ば', 'ば'},                                  // 0x3070 ば
+	{0x3071, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'パ', 'ﾊ', 'ぱ', 'は', 'ぱ'},                              // 0x3071 ぱ
+	{0x3072, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ヒ', 'ﾋ', 'び', 'ぴ', 'ひ'},                                // 0x3072 ひ
+	{0x3073, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ビ', 'ﾋ', 'ひ', 'び', 'び'},                                  // 0x3073 び
+	{0x3074, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'ピ', 'ﾋ', 'ぴ', 'ひ', 'ぴ'},                              // 0x3074 ぴ
+	{0x3075, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'フ', 'ﾌ', 'ぶ', 'ぷ', 'ふ'},                                // 0x3075 ふ
+	{0x3076, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ブ', 'ﾌ', 'ふ', 'ぶ', 'ぶ'},                                  // 0x3076 ぶ
+	{0x3077, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'プ', 'ﾌ', 'ぷ', 'ふ', 'ぷ'},                              // 0x3077 ぷ
+	{0x3078, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ヘ', 'ﾍ', 'べ', 'ぺ', 'へ'},                                // 0x3078 へ
+	{0x3079, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ベ', 'ﾍ', 'へ', 'べ', 'べ'},                                  // 0x3079 べ
+	{0x307A, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'ペ', 'ﾍ', 'ぺ', 'へ', 'ぺ'},                              // 0x307A ぺ
+	{0x307B, ctKanaLetter, ccHiragana, cwWide, vcUnvoiced, 'ホ', 'ﾎ', 'ぼ', 'ぽ', 'ほ'},                                // 0x307B ほ
+	{0x307C, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ボ', 'ﾎ', 'ほ', 'ぼ', 'ぼ'},                                  // 0x307C ぼ
+	{0x307D, ctKanaLetter, ccHiragana, cwWide, vcSemivoiced, 'ポ', 'ﾎ', 'ぽ', 'ほ', 'ぽ'},                              // 0x307D ぽ
+	{0x307E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'マ', 'ﾏ', 'ま', 'ま', 'ま'},                               // 0x307E ま
+	{0x307F, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ミ', 'ﾐ', 'み', 'み', 'み'},                               // 0x307F み
+	{0x3080, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ム', 'ﾑ', 'む', 'む', 'む'},                               // 0x3080 む
+	{0x3081, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'メ', 'ﾒ', 'め', 'め', 'め'},                               // 0x3081 め
+	{0x3082, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'モ', 'ﾓ', 'も', 'も', 'も'},                               // 0x3082 も
+	{0x3083, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ャ', 'ｬ', 'ゃ', 'ゃ', 'や'},                               // 0x3083 ゃ
+	{0x3084, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヤ', 'ﾔ', 'や', 'や', 'や'},                               // 0x3084 や
+	{0x3085, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ュ', 'ｭ', 'ゅ', 'ゅ', 'ゆ'},                               // 0x3085 ゅ
+	{0x3086, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ユ', 'ﾕ', 'ゆ', 'ゆ', 'ゆ'},                               // 0x3086 ゆ
+	{0x3087, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ョ', 'ｮ', 'ょ', 'ょ', 'よ'},                               // 0x3087 ょ
+	{0x3088, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヨ', 'ﾖ', 'よ', 'よ', 'よ'},                               // 0x3088 よ
+	{0x3089, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ラ', 'ﾗ', 'ら', 'ら', 'ら'},                               // 0x3089 ら
+	{0x308A, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'リ', 'ﾘ', 'り', 'り', 'り'},                               // 0x308A り
+	{0x308B, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ル', 'ﾙ', 'る', 'る', 'る'},                               // 0x308B る
+	{0x308C, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'レ', 'ﾚ', 'れ', 'れ', 'れ'},                               // 0x308C れ
+	{0x308D, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ロ', 'ﾛ', 'ろ', 'ろ', 'ろ'},                               // 0x308D ろ
+	{0x308E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヮ', 'ﾜ', 'ゎ', 'ゎ', 'わ'},                               // 0x308E ゎ
+	{0x308F, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ワ', 'ﾜ', 'わ', 'わ', 'わ'},                               // 0x308F わ
+	{0x3090, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヰ', 'ｲ', 'ゐ', 'ゐ', 'ゐ'},                               // 0x3090 ゐ
+	{0x3091, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヱ', 'ｴ', 'ゑ', 'ゑ', 'ゑ'},                               // 0x3091 ゑ
+	{0x3092, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヲ', 'ｦ', 'を', 'を', 'を'},                               // 0x3092 を
+	{0x3093, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ン', 'ﾝ', 'ん', 'ん', 'ん'},                               // 0x3093 ん
+	{0x3094, ctKanaLetter, ccHiragana, cwWide, vcVoiced, 'ヴ', 'ｳ', 'う', 'ゔ', 'ゔ'},                                  // 0x3094 ゔ
+	{0x3095, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヵ', 'ｶ', 'ゕ', 'ゕ', 'か'},                               // 0x3095 ゕ
+	{0x3096, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヶ', 'ｹ', 'ゖ', 'ゖ', 'け'},                               // 0x3096 ゖ
+	{0x3097, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u3097', '\u3097', '\u3097', '\u3097', '\u3097'}, // 0x3097 ゗
+	{0x3098, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u3098', '\u3098', '\u3098', '\u3098', '\u3098'}, // 0x3098 ゘
+	{0x3099, ctKanaVom, ccCombining, cwWide, vcUndefined, '゛', 'ﾞ', '゙', '゙', '゙'},                                 // 0x3099 ゙
+	{0x309A, ctKanaVom, ccCombining, cwWide, vcUndefined, '゜', 'ﾟ', '゚', '゚', '゚'},                                 // 0x309A ゚
+	{0x309B, ctKanaVom, ccLegacy, cwWide, vcUndefined, '゙', 'ﾞ', '゛', '゛', '゛'},                                    // 0x309B ゛
+	{0x309C, ctKanaVom, ccLegacy, cwWide, vcUndefined, '゚', 'ﾟ', '゜', '゜', '゜'},                                    // 0x309C ゜
+	{0x309D, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヽ', 'ゝ', 'ゝ', 'ゝ', 'ゝ'},                               // 0x309D ゝ
+	{0x309E, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ヾ', 'ゞ', 'ゞ', 'ゞ', 'ゞ'},                               // 0x309E ゞ
+	{0x309F, ctKanaLetter, ccHiragana, cwWide, vcUndefined, 'ゟ', 'ゟ', 'ゟ', 'ゟ', 'ゟ'},                               // 0x309F ゟ
+	{0x30A0, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '゠', '゠', '゠', '゠', '゠'},                              // 0x30A0 ゠
+	{0x30A1, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぁ', 'ｧ', 'ァ', 'ァ', 'ア'},                               // 0x30A1 ァ
+	{0x30A2, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'あ', 'ｱ', 'ア', 'ア', 'ア'},                               // 0x30A2 ア
+	{0x30A3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぃ', 'ｨ', 'ィ', 'ィ', 'イ'},                               // 0x30A3 ィ
+	{0x30A4, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'い', 'ｲ', 'イ', 'イ', 'イ'},                               // 0x30A4 イ
+	{0x30A5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぅ', 'ｩ', 'ゥ', 'ゥ', 'ウ'},                               // 0x30A5 ゥ
+	{0x30A6, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'う', 'ｳ', 'ヴ', 'ウ', 'ウ'},                                // 0x30A6 ウ
+	{0x30A7, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぇ', 'ｪ', 'ェ', 'ェ', 'エ'},                               // 0x30A7 ェ
+	{0x30A8, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'え', 'ｴ', 'エ', 'エ', 'エ'},                               // 0x30A8 エ
+	{0x30A9, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぉ', 'ｫ', 'ォ', 'ォ', 'オ'},                               // 0x30A9 ォ
+	{0x30AA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'お', 'ｵ', 'オ', 'オ', 'オ'},                               // 0x30AA オ
+	{0x30AB, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'か', 'ｶ', 'ガ', 'カ', 'カ'},                                // 0x30AB カ
+	{0x30AC, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'が', 'ｶ', 'カ', 'ガ', 'ガ'},                                  // 0x30AC ガ
+	{0x30AD, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'き', 'ｷ', 'ギ', 'キ', 'キ'},                                // 0x30AD キ
+	{0x30AE, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぎ', 'ｷ', 'キ', 'ギ', 'ギ'},                                  // 0x30AE ギ
+	{0x30AF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'く', 'ｸ', 'グ', 'ク', 'ク'},                                // 0x30AF ク
+	{0x30B0, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぐ', 'ｸ', 'ク', 'グ', 'グ'},                                  // 0x30B0 グ
+	{0x30B1, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'け', 'ｹ', 'ゲ', 'ケ', 'ケ'},                                // 0x30B1 ケ
+	{0x30B2, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'げ', 'ｹ', 'ケ', 'ゲ', 'ゲ'},                                  // 0x30B2 ゲ
+	{0x30B3, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'こ', 'ｺ', 'ゴ', 'コ', 'コ'},                                // 0x30B3 コ
+	{0x30B4, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ご', 'ｺ', 'コ', 'ゴ', 'ゴ'},                                  // 0x30B4 ゴ
+	{0x30B5, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'さ', 'ｻ', 'ザ', 'サ', 'サ'},                                // 0x30B5 サ
+	{0x30B6, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ざ', 'ｻ', 'サ', 'ザ', 'ザ'},                                  // 0x30B6 ザ
+	{0x30B7, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'し', 'ｼ', 'ジ', 'シ', 'シ'},                                // 0x30B7 シ
+	{0x30B8, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'じ', 'ｼ', 'シ', 'ジ', 'ジ'},                                  // 0x30B8 ジ
+	{0x30B9, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'す', 'ｽ', 'ズ', 'ス', 'ス'},                                // 0x30B9 ス
+	{0x30BA, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ず', 'ｽ', 'ス', 'ズ', 'ズ'},                                  // 0x30BA ズ
+	{0x30BB, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'せ', 'ｾ', 'ゼ', 'セ', 'セ'},                                // 0x30BB セ
+	{0x30BC, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぜ', 'ｾ', 'セ', 'ゼ', 'ゼ'},                                  // 0x30BC ゼ
+	{0x30BD, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'そ', 'ｿ', 'ゾ', 'ソ', 'ソ'},                                // 0x30BD ソ
+	{0x30BE, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぞ', 'ｿ', 'ソ', 'ゾ', 'ゾ'},                                  // 0x30BE ゾ
+	{0x30BF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'た', 'ﾀ', 'ダ', 'タ', 'タ'},                                // 0x30BF タ
+	{0x30C0, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'だ', 'ﾀ', 'タ', 'ダ', 'ダ'},                                  // 0x30C0 ダ
+	{0x30C1, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ち', 'ﾁ', 'ヂ', 'チ', 'チ'},                                // 0x30C1 チ
+	{0x30C2, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぢ', 'ﾁ', 'チ', 'ヂ', 'ヂ'},                                  // 0x30C2 ヂ
+	{0x30C3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'っ', 'ｯ', 'ッ', 'ッ', 'ツ'},                               // 0x30C3 ッ
+	{0x30C4, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'つ', 'ﾂ', 'ヅ', 'ツ', 'ツ'},                                // 0x30C4 ツ
+	{0x30C5, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'づ', 'ﾂ', 'ツ', 'ヅ', 'ヅ'},                                  // 0x30C5 ヅ
+	{0x30C6, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'て', 'ﾃ', 'デ', 'テ', 'テ'},                                // 0x30C6 テ
+	{0x30C7, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'で', 'ﾃ', 'テ', 'デ', 'デ'},                                  // 0x30C7 デ
+	{0x30C8, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'と', 'ﾄ', 'ド', 'ト', 'ト'},                                // 0x30C8 ト
+	{0x30C9, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ど', 'ﾄ', 'ト', 'ド', 'ド'},                                  // 0x30C9 ド
+	{0x30CA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'な', 'ﾅ', 'ナ', 'ナ', 'ナ'},                               // 0x30CA ナ
+	{0x30CB, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'に', 'ﾆ', 'ニ', 'ニ', 'ニ'},                               // 0x30CB ニ
+	{0x30CC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぬ', 'ﾇ', 'ヌ', 'ヌ', 'ヌ'},                               // 0x30CC ヌ
+	{0x30CD, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ね', 'ﾈ', 'ネ', 'ネ', 'ネ'},                               // 0x30CD ネ
+	{0x30CE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'の', 'ﾉ', 'ノ', 'ノ', 'ノ'},                               // 0x30CE ノ
+	{0x30CF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'は', 'ﾊ', 'バ', 'パ', 'ハ'},                                // 0x30CF ハ
+	{0x30D0, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ば', 'ﾊ', 'ハ', 'バ', 'バ'},                                  // 0x30D0 バ
+	{0x30D1, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぱ', 'ﾊ', 'パ', 'ハ', 'パ'},                              // 0x30D1 パ
+	{0x30D2, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ひ', 'ﾋ', 'ビ', 'ピ', 'ヒ'},                                // 0x30D2 ヒ
+	{0x30D3, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'び', 'ﾋ', 'ヒ', 'ビ', 'ビ'},                                  // 0x30D3 ビ
+	{0x30D4, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぴ', 'ﾋ', 'ピ', 'ヒ', 'ピ'},                              // 0x30D4 ピ
+	{0x30D5, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ふ', 'ﾌ', 'ブ', 'プ', 'フ'},                                // 0x30D5 フ
+	{0x30D6, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぶ', 'ﾌ', 'フ', 'ブ', 'ブ'},                                  // 0x30D6 ブ
+	{0x30D7, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぷ', 'ﾌ', 'プ', 'フ', 'プ'},                              // 0x30D7 プ
+	{0x30D8, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'へ', 'ﾍ', 'ベ', 'ペ', 'ヘ'},                                // 0x30D8 ヘ
+	{0x30D9, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'べ', 'ﾍ', 'ヘ', 'ベ', 'ベ'},                                  // 0x30D9 ベ
+	{0x30DA, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぺ', 'ﾍ', 'ペ', 'ヘ', 'ペ'},                              // 0x30DA ペ
+	{0x30DB, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ほ', 'ﾎ', 'ボ', 'ポ', 'ホ'},                                // 0x30DB ホ
+	{0x30DC, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ぼ', 'ﾎ', 'ホ', 'ボ', 'ボ'},                                  // 0x30DC ボ
+	{0x30DD, ctKanaLetter, ccKatakana, cwWide, vcSemivoiced, 'ぽ', 'ﾎ', 'ポ', 'ホ', 'ポ'},                              // 0x30DD ポ
+	{0x30DE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ま', 'ﾏ', 'マ', 'マ', 'マ'},                               // 0x30DE マ
+	{0x30DF, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'み', 'ﾐ', 'ミ', 'ミ', 'ミ'},                               // 0x30DF ミ
+	{0x30E0, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'む', 'ﾑ', 'ム', 'ム', 'ム'},                               // 0x30E0 ム
+	{0x30E1, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'め', 'ﾒ', 'メ', 'メ', 'メ'},                               // 0x30E1 メ
+	{0x30E2, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'も', 'ﾓ', 'モ', 'モ', 'モ'},                               // 0x30E2 モ
+	{0x30E3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゃ', 'ｬ', 'ャ', 'ャ', 'ヤ'},                               // 0x30E3 ャ
+	{0x30E4, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'や', 'ﾔ', 'ヤ', 'ヤ', 'ヤ'},                               // 0x30E4 ヤ
+	{0x30E5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゅ', 'ｭ', 'ュ', 'ュ', 'ユ'},                               // 0x30E5 ュ
+	{0x30E6, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゆ', 'ﾕ', 'ユ', 'ユ', 'ユ'},                               // 0x30E6 ユ
+	{0x30E7, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ょ', 'ｮ', 'ョ', 'ョ', 'ヨ'},                               // 0x30E7 ョ
+	{0x30E8, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'よ', 'ﾖ', 'ヨ', 'ヨ', 'ヨ'},                               // 0x30E8 ヨ
+	{0x30E9, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ら', 'ﾗ', 'ラ', 'ラ', 'ラ'},                               // 0x30E9 ラ
+	{0x30EA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'り', 'ﾘ', 'リ', 'リ', 'リ'},                               // 0x30EA リ
+	{0x30EB, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'る', 'ﾙ', 'ル', 'ル', 'ル'},                               // 0x30EB ル
+	{0x30EC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'れ', 'ﾚ', 'レ', 'レ', 'レ'},                               // 0x30EC レ
+	{0x30ED, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ろ', 'ﾛ', 'ロ', 'ロ', 'ロ'},                               // 0x30ED ロ
+	{0x30EE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゎ', 'ﾜ', 'ヮ', 'ヮ', 'ワ'},                               // 0x30EE ヮ
+	{0x30EF, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'わ', 'ﾜ', 'ヷ', 'ワ', 'ワ'},                                // 0x30EF ワ
+	{0x30F0, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ゐ', 'ｲ', 'ヸ', 'ヰ', 'ヰ'},                                // 0x30F0 ヰ
+	{0x30F1, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'ゑ', 'ｴ', 'ヹ', 'ヱ', 'ヱ'},                                // 0x30F1 ヱ
+	{0x30F2, ctKanaLetter, ccKatakana, cwWide, vcUnvoiced, 'を', 'ｦ', 'ヺ', 'ヲ', 'ヲ'},                                // 0x30F2 ヲ
+	{0x30F3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ん', 'ﾝ', 'ン', 'ン', 'ン'},                               // 0x30F3 ン
+	{0x30F4, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ゔ', 'ｳ', 'ウ', 'ヴ', 'ヴ'},                                  // 0x30F4 ヴ
+	{0x30F5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゕ', 'ｶ', 'ヵ', 'ヵ', 'カ'},                               // 0x30F5 ヵ
+	{0x30F6, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゖ', 'ｹ', 'ヶ', 'ヶ', 'ケ'},                               // 0x30F6 ヶ
+	{0x30F7, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'わ', 'ﾜ', 'ワ', 'ヷ', 'ヷ'},                                  // 0x30F7 ヷ
+	{0x30F8, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ゐ', 'ｲ', 'ヰ', 'ヸ', 'ヸ'},                                  // 0x30F8 ヸ
+	{0x30F9, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'ゑ', 'ｴ', 'ヱ', 'ヹ', 'ヹ'},                                  // 0x30F9 ヹ
+	{0x30FA, ctKanaLetter, ccKatakana, cwWide, vcVoiced, 'を', 'ｦ', 'ヲ', 'ヺ', 'ヺ'},                                  // 0x30FA ヺ
+	{0x30FB, ctKanaSymbol, ccUndefined, cwWide, vcUndefined, '・', '･', '・', '・', '・'},                              // 0x30FB ・
+	{0x30FC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ー', 'ｰ', 'ー', 'ー', 'ー'},                               // 0x30FC ー
+	{0x30FD, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゝ', 'ヽ', 'ヽ', 'ヽ', 'ヽ'},                               // 0x30FD ヽ
+	{0x30FE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ゞ', 'ヾ', 'ヾ', 'ヾ', 'ヾ'},                               // 0x30FE ヾ
+	{0x30FF, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ヿ', 'ヿ', 'ヿ', 'ヿ', 'ヿ'},                               // 0x30FF ヿ
 }
 
 var kanaExtFirst rune = 0x31F0
 var kanaExtLast rune = 0x31FF
 var kanaExtTable = unichars{
-	{0x31F0, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'く', 'ｸ', 'ㇰ', 'ㇰ'}, // 0x31F0 ㇰ
-	{0x31F1, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'し', 'ｼ', 'ㇱ', 'ㇱ'}, // 0x31F1 ㇱ
-	{0x31F2, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'す', 'ｽ', 'ㇲ', 'ㇲ'}, // 0x31F2 ㇲ
-	{0x31F3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'と', 'ﾄ', 'ㇳ', 'ㇳ'}, // 0x31F3 ㇳ
-	{0x31F4, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぬ', 'ﾇ', 'ㇴ', 'ㇴ'}, // 0x31F4 ㇴ
-	{0x31F5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'は', 'ﾊ', 'ㇵ', 'ㇵ'}, // 0x31F5 ㇵ
-	{0x31F6, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ひ', 'ﾋ', 'ㇶ', 'ㇶ'}, // 0x31F6 ㇶ
-	{0x31F7, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ふ', 'ﾌ', 'ㇷ', 'ㇷ'}, // 0x31F7 ㇷ
-	{0x31F8, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'へ', 'ﾍ', 'ㇸ', 'ㇸ'}, // 0x31F8 ㇸ
-	{0x31F9, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ほ', 'ﾎ', 'ㇹ', 'ㇹ'}, // 0x31F9 ㇹ
-	{0x31FA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'む', 'ﾑ', 'ㇺ', 'ㇺ'}, // 0x31FA ㇺ
-	{0x31FB, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ら', 'ﾗ', 'ㇻ', 'ㇻ'}, // 0x31FB ㇻ
-	{0x31FC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'り', 'ﾘ', 'ㇼ', 'ㇼ'}, // 0x31FC ㇼ
-	{0x31FD, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'る', 'ﾙ', 'ㇽ', 'ㇽ'}, // 0x31FD ㇽ
-	{0x31FE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'れ', 'ﾚ', 'ㇾ', 'ㇾ'}, // 0x31FE ㇾ
-	{0x31FF, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ろ', 'ﾛ', 'ㇿ', 'ㇿ'}, // 0x31FF ㇿ
+	{0x31F0, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'く', 'ｸ', 'ㇰ', 'ㇰ', 'ク'}, // 0x31F0 ㇰ
+	{0x31F1, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'し', 'ｼ', 'ㇱ', 'ㇱ', 'シ'}, // 0x31F1 ㇱ
+	{0x31F2, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'す', 'ｽ', 'ㇲ', 'ㇲ', 'ス'}, // 0x31F2 ㇲ
+	{0x31F3, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'と', 'ﾄ', 'ㇳ', 'ㇳ', 'ト'}, // 0x31F3 ㇳ
+	{0x31F4, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ぬ', 'ﾇ', 'ㇴ', 'ㇴ', 'ヌ'}, // 0x31F4 ㇴ
+	{0x31F5, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'は', 'ﾊ', 'ㇵ', 'ㇵ', 'ハ'}, // 0x31F5 ㇵ
+	{0x31F6, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ひ', 'ﾋ', 'ㇶ', 'ㇶ', 'ヒ'}, // 0x31F6 ㇶ
+	{0x31F7, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ふ', 'ﾌ', 'ㇷ', 'ㇷ', 'フ'}, // 0x31F7 ㇷ
+	{0x31F8, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'へ', 'ﾍ', 'ㇸ', 'ㇸ', 'ヘ'}, // 0x31F8 ㇸ
+	{0x31F9, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ほ', 'ﾎ', 'ㇹ', 'ㇹ', 'ホ'}, // 0x31F9 ㇹ
+	{0x31FA, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'む', 'ﾑ', 'ㇺ', 'ㇺ', 'ム'}, // 0x31FA ㇺ
+	{0x31FB, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ら', 'ﾗ', 'ㇻ', 'ㇻ', 'ラ'}, // 0x31FB ㇻ
+	{0x31FC, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'り', 'ﾘ', 'ㇼ', 'ㇼ', 'リ'}, // 0x31FC ㇼ
+	{0x31FD, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'る', 'ﾙ', 'ㇽ', 'ㇽ', 'ル'}, // 0x31FD ㇽ
+	{0x31FE, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'れ', 'ﾚ', 'ㇾ', 'ㇾ', 'レ'}, // 0x31FE ㇾ
+	{0x31FF, ctKanaLetter, ccKatakana, cwWide, vcUndefined, 'ろ', 'ﾛ', 'ㇿ', 'ㇿ', 'ロ'}, // 0x31FF ㇿ
+}
+
+var enclosedCJKFirst rune = 0x3200
+var enclosedCJKLast rune = 0x32FF
+var enclosedCJKTable = unichars{
+	{0x3200, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈀', '㈀', '㈀', '㈀', '㈀'},                           // 0x3200 ㈀
+	{0x3201, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈁', '㈁', '㈁', '㈁', '㈁'},                           // 0x3201 ㈁
+	{0x3202, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈂', '㈂', '㈂', '㈂', '㈂'},                           // 0x3202 ㈂
+	{0x3203, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈃', '㈃', '㈃', '㈃', '㈃'},                           // 0x3203 ㈃
+	{0x3204, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈄', '㈄', '㈄', '㈄', '㈄'},                           // 0x3204 ㈄
+	{0x3205, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈅', '㈅', '㈅', '㈅', '㈅'},                           // 0x3205 ㈅
+	{0x3206, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈆', '㈆', '㈆', '㈆', '㈆'},                           // 0x3206 ㈆
+	{0x3207, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈇', '㈇', '㈇', '㈇', '㈇'},                           // 0x3207 ㈇
+	{0x3208, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈈', '㈈', '㈈', '㈈', '㈈'},                           // 0x3208 ㈈
+	{0x3209, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈉', '㈉', '㈉', '㈉', '㈉'},                           // 0x3209 ㈉
+	{0x320A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈊', '㈊', '㈊', '㈊', '㈊'},                           // 0x320A ㈊
+	{0x320B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈋', '㈋', '㈋', '㈋', '㈋'},                           // 0x320B ㈋
+	{0x320C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈌', '㈌', '㈌', '㈌', '㈌'},                           // 0x320C ㈌
+	{0x320D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈍', '㈍', '㈍', '㈍', '㈍'},                           // 0x320D ㈍
+	{0x320E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈎', '㈎', '㈎', '㈎', '㈎'},                           // 0x320E ㈎
+	{0x320F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈏', '㈏', '㈏', '㈏', '㈏'},                           // 0x320F ㈏
+	{0x3210, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈐', '㈐', '㈐', '㈐', '㈐'},                           // 0x3210 ㈐
+	{0x3211, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈑', '㈑', '㈑', '㈑', '㈑'},                           // 0x3211 ㈑
+	{0x3212, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈒', '㈒', '㈒', '㈒', '㈒'},                           // 0x3212 ㈒
+	{0x3213, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈓', '㈓', '㈓', '㈓', '㈓'},                           // 0x3213 ㈓
+	{0x3214, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈔', '㈔', '㈔', '㈔', '㈔'},                           // 0x3214 ㈔
+	{0x3215, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈕', '㈕', '㈕', '㈕', '㈕'},                           // 0x3215 ㈕
+	{0x3216, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈖', '㈖', '㈖', '㈖', '㈖'},                           // 0x3216 ㈖
+	{0x3217, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈗', '㈗', '㈗', '㈗', '㈗'},                           // 0x3217 ㈗
+	{0x3218, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈘', '㈘', '㈘', '㈘', '㈘'},                           // 0x3218 ㈘
+	{0x3219, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈙', '㈙', '㈙', '㈙', '㈙'},                           // 0x3219 ㈙
+	{0x321A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈚', '㈚', '㈚', '㈚', '㈚'},                           // 0x321A ㈚
+	{0x321B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈛', '㈛', '㈛', '㈛', '㈛'},                           // 0x321B ㈛
+	{0x321C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈜', '㈜', '㈜', '㈜', '㈜'},                           // 0x321C ㈜
+	{0x321D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈝', '㈝', '㈝', '㈝', '㈝'},                           // 0x321D ㈝
+	{0x321E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈞', '㈞', '㈞', '㈞', '㈞'},                           // 0x321E ㈞
+	{0x321F, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\u321f', '\u321f', '\u321f', '\u321f', '\u321f'}, // 0x321F ㈟
+	{0x3220, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈠', '㈠', '㈠', '㈠', '㈠'},                           // 0x3220 ㈠
+	{0x3221, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈡', '㈡', '㈡', '㈡', '㈡'},                           // 0x3221 ㈡
+	{0x3222, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈢', '㈢', '㈢', '㈢', '㈢'},                           // 0x3222 ㈢
+	{0x3223, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈣', '㈣', '㈣', '㈣', '㈣'},                           // 0x3223 ㈣
+	{0x3224, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈤', '㈤', '㈤', '㈤', '㈤'},                           // 0x3224 ㈤
+	{0x3225, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈥', '㈥', '㈥', '㈥', '㈥'},                           // 0x3225 ㈥
+	{0x3226, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈦', '㈦', '㈦', '㈦', '㈦'},                           // 0x3226 ㈦
+	{0x3227, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈧', '㈧', '㈧', '㈧', '㈧'},                           // 0x3227 ㈧
+	{0x3228, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈨', '㈨', '㈨', '㈨', '㈨'},                           // 0x3228 ㈨
+	{0x3229, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈩', '㈩', '㈩', '㈩', '㈩'},                           // 0x3229 ㈩
+	{0x322A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈪', '㈪', '㈪', '㈪', '㈪'},                           // 0x322A ㈪
+	{0x322B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈫', '㈫', '㈫', '㈫', '㈫'},                           // 0x322B ㈫
+	{0x322C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈬', '㈬', '㈬', '㈬', '㈬'},                           // 0x322C ㈬
+	{0x322D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈭', '㈭', '㈭', '㈭', '㈭'},                           // 0x322D ㈭
+	{0x322E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈮', '㈮', '㈮', '㈮', '㈮'},                           // 0x322E ㈮
+	{0x322F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈯', '㈯', '㈯', '㈯', '㈯'},                           // 0x322F ㈯
+	{0x3230, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈰', '㈰', '㈰', '㈰', '㈰'},                           // 0x3230 ㈰
+	{0x3231, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈱', '㈱', '㈱', '㈱', '㈱'},                           // 0x3231 ㈱
+	{0x3232, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈲', '㈲', '㈲', '㈲', '㈲'},                           // 0x3232 ㈲
+	{0x3233, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈳', '㈳', '㈳', '㈳', '㈳'},                           // 0x3233 ㈳
+	{0x3234, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈴', '㈴', '㈴', '㈴', '㈴'},                           // 0x3234 ㈴
+	{0x3235, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈵', '㈵', '㈵', '㈵', '㈵'},                           // 0x3235 ㈵
+	{0x3236, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈶', '㈶', '㈶', '㈶', '㈶'},                           // 0x3236 ㈶
+	{0x3237, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈷', '㈷', '㈷', '㈷', '㈷'},                           // 0x3237 ㈷
+	{0x3238, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈸', '㈸', '㈸', '㈸', '㈸'},                           // 0x3238 ㈸
+	{0x3239, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈹', '㈹', '㈹', '㈹', '㈹'},                           // 0x3239 ㈹
+	{0x323A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈺', '㈺', '㈺', '㈺', '㈺'},                           // 0x323A ㈺
+	{0x323B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈻', '㈻', '㈻', '㈻', '㈻'},                           // 0x323B ㈻
+	{0x323C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈼', '㈼', '㈼', '㈼', '㈼'},                           // 0x323C ㈼
+	{0x323D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈽', '㈽', '㈽', '㈽', '㈽'},                           // 0x323D ㈽
+	{0x323E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈾', '㈾', '㈾', '㈾', '㈾'},                           // 0x323E ㈾
+	{0x323F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㈿', '㈿', '㈿', '㈿', '㈿'},                           // 0x323F ㈿
+	{0x3240, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉀', '㉀', '㉀', '㉀', '㉀'},                           // 0x3240 ㉀
+	{0x3241, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉁', '㉁', '㉁', '㉁', '㉁'},                           // 0x3241 ㉁
+	{0x3242, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉂', '㉂', '㉂', '㉂', '㉂'},                           // 0x3242 ㉂
+	{0x3243, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉃', '㉃', '㉃', '㉃', '㉃'},                           // 0x3243 ㉃
+	{0x3244, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉄', '㉄', '㉄', '㉄', '㉄'},                           // 0x3244 ㉄
+	{0x3245, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉅', '㉅', '㉅', '㉅', '㉅'},                           // 0x3245 ㉅
+	{0x3246, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉆', '㉆', '㉆', '㉆', '㉆'},                           // 0x3246 ㉆
+	{0x3247, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉇', '㉇', '㉇', '㉇', '㉇'},                           // 0x3247 ㉇
+	{0x3248, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉈', '㉈', '㉈', '㉈', '㉈'},                          // 0x3248 ㉈
+	{0x3249, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉉', '㉉', '㉉', '㉉', '㉉'},                          // 0x3249 ㉉
+	{0x324A, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉊', '㉊', '㉊', '㉊', '㉊'},                          // 0x324A ㉊
+	{0x324B, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉋', '㉋', '㉋', '㉋', '㉋'},                          // 0x324B ㉋
+	{0x324C, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉌', '㉌', '㉌', '㉌', '㉌'},                          // 0x324C ㉌
+	{0x324D, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉍', '㉍', '㉍', '㉍', '㉍'},                          // 0x324D ㉍
+	{0x324E, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉎', '㉎', '㉎', '㉎', '㉎'},                          // 0x324E ㉎
+	{0x324F, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉏', '㉏', '㉏', '㉏', '㉏'},                          // 0x324F ㉏
+	{0x3250, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉐', '㉐', '㉐', '㉐', '㉐'},                           // 0x3250 ㉐
+	{0x3251, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉑', '㉑', '㉑', '㉑', '㉑'},                           // 0x3251 ㉑
+	{0x3252, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉒', '㉒', '㉒', '㉒', '㉒'},                           // 0x3252 ㉒
+	{0x3253, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉓', '㉓', '㉓', '㉓', '㉓'},                           // 0x3253 ㉓
+	{0x3254, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉔', '㉔', '㉔', '㉔', '㉔'},                           // 0x3254 ㉔
+	{0x3255, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉕', '㉕', '㉕', '㉕', '㉕'},                           // 0x3255 ㉕
+	{0x3256, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉖', '㉖', '㉖', '㉖', '㉖'},                           // 0x3256 ㉖
+	{0x3257, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉗', '㉗', '㉗', '㉗', '㉗'},                           // 0x3257 ㉗
+	{0x3258, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉘', '㉘', '㉘', '㉘', '㉘'},                           // 0x3258 ㉘
+	{0x3259, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉙', '㉙', '㉙', '㉙', '㉙'},                           // 0x3259 ㉙
+	{0x325A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉚', '㉚', '㉚', '㉚', '㉚'},                           // 0x325A ㉚
+	{0x325B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉛', '㉛', '㉛', '㉛', '㉛'},                           // 0x325B ㉛
+	{0x325C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉜', '㉜', '㉜', '㉜', '㉜'},                           // 0x325C ㉜
+	{0x325D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉝', '㉝', '㉝', '㉝', '㉝'},                           // 0x325D ㉝
+	{0x325E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉞', '㉞', '㉞', '㉞', '㉞'},                           // 0x325E ㉞
+	{0x325F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉟', '㉟', '㉟', '㉟', '㉟'},                           // 0x325F ㉟
+	{0x3260, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉠', '㉠', '㉠', '㉠', '㉠'},                           // 0x3260 ㉠
+	{0x3261, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉡', '㉡', '㉡', '㉡', '㉡'},                           // 0x3261 ㉡
+	{0x3262, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉢', '㉢', '㉢', '㉢', '㉢'},                           // 0x3262 ㉢
+	{0x3263, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉣', '㉣', '㉣', '㉣', '㉣'},                           // 0x3263 ㉣
+	{0x3264, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉤', '㉤', '㉤', '㉤', '㉤'},                           // 0x3264 ㉤
+	{0x3265, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉥', '㉥', '㉥', '㉥', '㉥'},                           // 0x3265 ㉥
+	{0x3266, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉦', '㉦', '㉦', '㉦', '㉦'},                           // 0x3266 ㉦
+	{0x3267, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉧', '㉧', '㉧', '㉧', '㉧'},                           // 0x3267 ㉧
+	{0x3268, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉨', '㉨', '㉨', '㉨', '㉨'},                           // 0x3268 ㉨
+	{0x3269, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉩', '㉩', '㉩', '㉩', '㉩'},                           // 0x3269 ㉩
+	{0x326A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉪', '㉪', '㉪', '㉪', '㉪'},                           // 0x326A ㉪
+	{0x326B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉫', '㉫', '㉫', '㉫', '㉫'},                           // 0x326B ㉫
+	{0x326C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉬', '㉬', '㉬', '㉬', '㉬'},                           // 0x326C ㉬
+	{0x326D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉭', '㉭', '㉭', '㉭', '㉭'},                           // 0x326D ㉭
+	{0x326E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉮', '㉮', '㉮', '㉮', '㉮'},                           // 0x326E ㉮
+	{0x326F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉯', '㉯', '㉯', '㉯', '㉯'},                           // 0x326F ㉯
+	{0x3270, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉰', '㉰', '㉰', '㉰', '㉰'},                           // 0x3270 ㉰
+	{0x3271, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉱', '㉱', '㉱', '㉱', '㉱'},                           // 0x3271 ㉱
+	{0x3272, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉲', '㉲', '㉲', '㉲', '㉲'},                           // 0x3272 ㉲
+	{0x3273, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉳', '㉳', '㉳', '㉳', '㉳'},                           // 0x3273 ㉳
+	{0x3274, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉴', '㉴', '㉴', '㉴', '㉴'},                           // 0x3274 ㉴
+	{0x3275, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉵', '㉵', '㉵', '㉵', '㉵'},                           // 0x3275 ㉵
+	{0x3276, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉶', '㉶', '㉶', '㉶', '㉶'},                           // 0x3276 ㉶
+	{0x3277, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉷', '㉷', '㉷', '㉷', '㉷'},                           // 0x3277 ㉷
+	{0x3278, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉸', '㉸', '㉸', '㉸', '㉸'},                           // 0x3278 ㉸
+	{0x3279, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉹', '㉹', '㉹', '㉹', '㉹'},                           // 0x3279 ㉹
+	{0x327A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉺', '㉺', '㉺', '㉺', '㉺'},                           // 0x327A ㉺
+	{0x327B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉻', '㉻', '㉻', '㉻', '㉻'},                           // 0x327B ㉻
+	{0x327C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉼', '㉼', '㉼', '㉼', '㉼'},                           // 0x327C ㉼
+	{0x327D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉽', '㉽', '㉽', '㉽', '㉽'},                           // 0x327D ㉽
+	{0x327E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㉾', '㉾', '㉾', '㉾', '㉾'},                           // 0x327E ㉾
+	{0x327F, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '㉿', '㉿', '㉿', '㉿', '㉿'},                          // 0x327F ㉿
+	{0x3280, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊀', '㊀', '㊀', '㊀', '㊀'},                           // 0x3280 ㊀
+	{0x3281, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊁', '㊁', '㊁', '㊁', '㊁'},                           // 0x3281 ㊁
+	{0x3282, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊂', '㊂', '㊂', '㊂', '㊂'},                           // 0x3282 ㊂
+	{0x3283, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊃', '㊃', '㊃', '㊃', '㊃'},                           // 0x3283 ㊃
+	{0x3284, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊄', '㊄', '㊄', '㊄', '㊄'},                           // 0x3284 ㊄
+	{0x3285, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊅', '㊅', '㊅', '㊅', '㊅'},                           // 0x3285 ㊅
+	{0x3286, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊆', '㊆', '㊆', '㊆', '㊆'},                           // 0x3286 ㊆
+	{0x3287, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊇', '㊇', '㊇', '㊇', '㊇'},                           // 0x3287 ㊇
+	{0x3288, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊈', '㊈', '㊈', '㊈', '㊈'},                           // 0x3288 ㊈
+	{0x3289, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊉', '㊉', '㊉', '㊉', '㊉'},                           // 0x3289 ㊉
+	{0x328A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊊', '㊊', '㊊', '㊊', '㊊'},                           // 0x328A ㊊
+	{0x328B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊋', '㊋', '㊋', '㊋', '㊋'},                           // 0x328B ㊋
+	{0x328C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊌', '㊌', '㊌', '㊌', '㊌'},                           // 0x328C ㊌
+	{0x328D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊍', '㊍', '㊍', '㊍', '㊍'},                           // 0x328D ㊍
+	{0x328E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊎', '㊎', '㊎', '㊎', '㊎'},                           // 0x328E ㊎
+	{0x328F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊏', '㊏', '㊏', '㊏', '㊏'},                           // 0x328F ㊏
+	{0x3290, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊐', '㊐', '㊐', '㊐', '㊐'},                           // 0x3290 ㊐
+	{0x3291, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊑', '㊑', '㊑', '㊑', '㊑'},                           // 0x3291 ㊑
+	{0x3292, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊒', '㊒', '㊒', '㊒', '㊒'},                           // 0x3292 ㊒
+	{0x3293, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊓', '㊓', '㊓', '㊓', '㊓'},                           // 0x3293 ㊓
+	{0x3294, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊔', '㊔', '㊔', '㊔', '㊔'},                           // 0x3294 ㊔
+	{0x3295, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊕', '㊕', '㊕', '㊕', '㊕'},                           // 0x3295 ㊕
+	{0x3296, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊖', '㊖', '㊖', '㊖', '㊖'},                           // 0x3296 ㊖
+	{0x3297, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊗', '㊗', '㊗', '㊗', '㊗'},                           // 0x3297 ㊗
+	{0x3298, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊘', '㊘', '㊘', '㊘', '㊘'},                           // 0x3298 ㊘
+	{0x3299, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊙', '㊙', '㊙', '㊙', '㊙'},                           // 0x3299 ㊙
+	{0x329A, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊚', '㊚', '㊚', '㊚', '㊚'},                           // 0x329A ㊚
+	{0x329B, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊛', '㊛', '㊛', '㊛', '㊛'},                           // 0x329B ㊛
+	{0x329C, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊜', '㊜', '㊜', '㊜', '㊜'},                           // 0x329C ㊜
+	{0x329D, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊝', '㊝', '㊝', '㊝', '㊝'},                           // 0x329D ㊝
+	{0x329E, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊞', '㊞', '㊞', '㊞', '㊞'},                           // 0x329E ㊞
+	{0x329F, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊟', '㊟', '㊟', '㊟', '㊟'},                           // 0x329F ㊟
+	{0x32A0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊠', '㊠', '㊠', '㊠', '㊠'},                           // 0x32A0 ㊠
+	{0x32A1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊡', '㊡', '㊡', '㊡', '㊡'},                           // 0x32A1 ㊡
+	{0x32A2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊢', '㊢', '㊢', '㊢', '㊢'},                           // 0x32A2 ㊢
+	{0x32A3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊣', '㊣', '㊣', '㊣', '㊣'},                           // 0x32A3 ㊣
+	{0x32A4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊤', '㊤', '㊤', '㊤', '㊤'},                           // 0x32A4 ㊤
+	{0x32A5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊥', '㊥', '㊥', '㊥', '㊥'},                           // 0x32A5 ㊥
+	{0x32A6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊦', '㊦', '㊦', '㊦', '㊦'},                           // 0x32A6 ㊦
+	{0x32A7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊧', '㊧', '㊧', '㊧', '㊧'},                           // 0x32A7 ㊧
+	{0x32A8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊨', '㊨', '㊨', '㊨', '㊨'},                           // 0x32A8 ㊨
+	{0x32A9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊩', '㊩', '㊩', '㊩', '㊩'},                           // 0x32A9 ㊩
+	{0x32AA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊪', '㊪', '㊪', '㊪', '㊪'},                           // 0x32AA ㊪
+	{0x32AB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊫', '㊫', '㊫', '㊫', '㊫'},                           // 0x32AB ㊫
+	{0x32AC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊬', '㊬', '㊬', '㊬', '㊬'},                           // 0x32AC ㊬
+	{0x32AD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊭', '㊭', '㊭', '㊭', '㊭'},                           // 0x32AD ㊭
+	{0x32AE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊮', '㊮', '㊮', '㊮', '㊮'},                           // 0x32AE ㊮
+	{0x32AF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊯', '㊯', '㊯', '㊯', '㊯'},                           // 0x32AF ㊯
+	{0x32B0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊰', '㊰', '㊰', '㊰', '㊰'},                           // 0x32B0 ㊰
+	{0x32B1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊱', '㊱', '㊱', '㊱', '㊱'},                           // 0x32B1 ㊱
+	{0x32B2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊲', '㊲', '㊲', '㊲', '㊲'},                           // 0x32B2 ㊲
+	{0x32B3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊳', '㊳', '㊳', '㊳', '㊳'},                           // 0x32B3 ㊳
+	{0x32B4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊴', '㊴', '㊴', '㊴', '㊴'},                           // 0x32B4 ㊴
+	{0x32B5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊵', '㊵', '㊵', '㊵', '㊵'},                           // 0x32B5 ㊵
+	{0x32B6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊶', '㊶', '㊶', '㊶', '㊶'},                           // 0x32B6 ㊶
+	{0x32B7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊷', '㊷', '㊷', '㊷', '㊷'},                           // 0x32B7 ㊷
+	{0x32B8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊸', '㊸', '㊸', '㊸', '㊸'},                           // 0x32B8 ㊸
+	{0x32B9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊹', '㊹', '㊹', '㊹', '㊹'},                           // 0x32B9 ㊹
+	{0x32BA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊺', '㊺', '㊺', '㊺', '㊺'},                           // 0x32BA ㊺
+	{0x32BB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊻', '㊻', '㊻', '㊻', '㊻'},                           // 0x32BB ㊻
+	{0x32BC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊼', '㊼', '㊼', '㊼', '㊼'},                           // 0x32BC ㊼
+	{0x32BD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊽', '㊽', '㊽', '㊽', '㊽'},                           // 0x32BD ㊽
+	{0x32BE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊾', '㊾', '㊾', '㊾', '㊾'},                           // 0x32BE ㊾
+	{0x32BF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㊿', '㊿', '㊿', '㊿', '㊿'},                           // 0x32BF ㊿
+	{0x32C0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋀', '㋀', '㋀', '㋀', '㋀'},                           // 0x32C0 ㋀
+	{0x32C1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋁', '㋁', '㋁', '㋁', '㋁'},                           // 0x32C1 ㋁
+	{0x32C2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋂', '㋂', '㋂', '㋂', '㋂'},                           // 0x32C2 ㋂
+	{0x32C3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋃', '㋃', '㋃', '㋃', '㋃'},                           // 0x32C3 ㋃
+	{0x32C4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋄', '㋄', '㋄', '㋄', '㋄'},                           // 0x32C4 ㋄
+	{0x32C5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋅', '㋅', '㋅', '㋅', '㋅'},                           // 0x32C5 ㋅
+	{0x32C6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋆', '㋆', '㋆', '㋆', '㋆'},                           // 0x32C6 ㋆
+	{0x32C7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋇', '㋇', '㋇', '㋇', '㋇'},                           // 0x32C7 ㋇
+	{0x32C8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋈', '㋈', '㋈', '㋈', '㋈'},                           // 0x32C8 ㋈
+	{0x32C9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋉', '㋉', '㋉', '㋉', '㋉'},                           // 0x32C9 ㋉
+	{0x32CA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋊', '㋊', '㋊', '㋊', '㋊'},                           // 0x32CA ㋊
+	{0x32CB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋋', '㋋', '㋋', '㋋', '㋋'},                           // 0x32CB ㋋
+	{0x32CC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋌', '㋌', '㋌', '㋌', '㋌'},                           // 0x32CC ㋌
+	{0x32CD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋍', '㋍', '㋍', '㋍', '㋍'},                           // 0x32CD ㋍
+	{0x32CE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋎', '㋎', '㋎', '㋎', '㋎'},                           // 0x32CE ㋎
+	{0x32CF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋏', '㋏', '㋏', '㋏', '㋏'},                           // 0x32CF ㋏
+	{0x32D0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋐', '㋐', '㋐', '㋐', '㋐'},                           // 0x32D0 ㋐
+	{0x32D1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋑', '㋑', '㋑', '㋑', '㋑'},                           // 0x32D1 ㋑
+	{0x32D2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋒', '㋒', '㋒', '㋒', '㋒'},                           // 0x32D2 ㋒
+	{0x32D3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋓', '㋓', '㋓', '㋓', '㋓'},                           // 0x32D3 ㋓
+	{0x32D4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋔', '㋔', '㋔', '㋔', '㋔'},                           // 0x32D4 ㋔
+	{0x32D5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋕', '㋕', '㋕', '㋕', '㋕'},                           // 0x32D5 ㋕
+	{0x32D6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋖', '㋖', '㋖', '㋖', '㋖'},                           // 0x32D6 ㋖
+	{0x32D7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋗', '㋗', '㋗', '㋗', '㋗'},                           // 0x32D7 ㋗
+	{0x32D8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋘', '㋘', '㋘', '㋘', '㋘'},                           // 0x32D8 ㋘
+	{0x32D9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋙', '㋙', '㋙', '㋙', '㋙'},                           // 0x32D9 ㋙
+	{0x32DA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋚', '㋚', '㋚', '㋚', '㋚'},                           // 0x32DA ㋚
+	{0x32DB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋛', '㋛', '㋛', '㋛', '㋛'},                           // 0x32DB ㋛
+	{0x32DC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋜', '㋜', '㋜', '㋜', '㋜'},                           // 0x32DC ㋜
+	{0x32DD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋝', '㋝', '㋝', '㋝', '㋝'},                           // 0x32DD ㋝
+	{0x32DE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋞', '㋞', '㋞', '㋞', '㋞'},                           // 0x32DE ㋞
+	{0x32DF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋟', '㋟', '㋟', '㋟', '㋟'},                           // 0x32DF ㋟
+	{0x32E0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋠', '㋠', '㋠', '㋠', '㋠'},                           // 0x32E0 ㋠
+	{0x32E1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋡', '㋡', '㋡', '㋡', '㋡'},                           // 0x32E1 ㋡
+	{0x32E2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋢', '㋢', '㋢', '㋢', '㋢'},                           // 0x32E2 ㋢
+	{0x32E3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋣', '㋣', '㋣', '㋣', '㋣'},                           // 0x32E3 ㋣
+	{0x32E4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋤', '㋤', '㋤', '㋤', '㋤'},                           // 0x32E4 ㋤
+	{0x32E5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋥', '㋥', '㋥', '㋥', '㋥'},                           // 0x32E5 ㋥
+	{0x32E6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋦', '㋦', '㋦', '㋦', '㋦'},                           // 0x32E6 ㋦
+	{0x32E7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋧', '㋧', '㋧', '㋧', '㋧'},                           // 0x32E7 ㋧
+	{0x32E8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋨', '㋨', '㋨', '㋨', '㋨'},                           // 0x32E8 ㋨
+	{0x32E9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋩', '㋩', '㋩', '㋩', '㋩'},                           // 0x32E9 ㋩
+	{0x32EA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋪', '㋪', '㋪', '㋪', '㋪'},                           // 0x32EA ㋪
+	{0x32EB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋫', '㋫', '㋫', '㋫', '㋫'},                           // 0x32EB ㋫
+	{0x32EC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋬', '㋬', '㋬', '㋬', '㋬'},                           // 0x32EC ㋬
+	{0x32ED, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋭', '㋭', '㋭', '㋭', '㋭'},                           // 0x32ED ㋭
+	{0x32EE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋮', '㋮', '㋮', '㋮', '㋮'},                           // 0x32EE ㋮
+	{0x32EF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋯', '㋯', '㋯', '㋯', '㋯'},                           // 0x32EF ㋯
+	{0x32F0, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋰', '㋰', '㋰', '㋰', '㋰'},                           // 0x32F0 ㋰
+	{0x32F1, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋱', '㋱', '㋱', '㋱', '㋱'},                           // 0x32F1 ㋱
+	{0x32F2, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋲', '㋲', '㋲', '㋲', '㋲'},                           // 0x32F2 ㋲
+	{0x32F3, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋳', '㋳', '㋳', '㋳', '㋳'},                           // 0x32F3 ㋳
+	{0x32F4, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋴', '㋴', '㋴', '㋴', '㋴'},                           // 0x32F4 ㋴
+	{0x32F5, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋵', '㋵', '㋵', '㋵', '㋵'},                           // 0x32F5 ㋵
+	{0x32F6, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋶', '㋶', '㋶', '㋶', '㋶'},                           // 0x32F6 ㋶
+	{0x32F7, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋷', '㋷', '㋷', '㋷', '㋷'},                           // 0x32F7 ㋷
+	{0x32F8, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋸', '㋸', '㋸', '㋸', '㋸'},                           // 0x32F8 ㋸
+	{0x32F9, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋹', '㋹', '㋹', '㋹', '㋹'},                           // 0x32F9 ㋹
+	{0x32FA, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋺', '㋺', '㋺', '㋺', '㋺'},                           // 0x32FA ㋺
+	{0x32FB, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋻', '㋻', '㋻', '㋻', '㋻'},                           // 0x32FB ㋻
+	{0x32FC, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋼', '㋼', '㋼', '㋼', '㋼'},                           // 0x32FC ㋼
+	{0x32FD, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋽', '㋽', '㋽', '㋽', '㋽'},                           // 0x32FD ㋽
+	{0x32FE, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋾', '㋾', '㋾', '㋾', '㋾'},                           // 0x32FE ㋾
+	{0x32FF, ctEnclosed, ccUndefined, cwUndefined, vcUndefined, '㋿', '㋿', '㋿', '㋿', '㋿'},                           // 0x32FF ㋿
+}
+
+var cjkCompatFirst rune = 0x3300
+var cjkCompatLast rune = 0x33FF
+var cjkCompatTable = unichars{
+	{0x3300, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌀', '㌀', '㌀', '㌀', '㌀'}, // 0x3300 ㌀
+	{0x3301, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌁', '㌁', '㌁', '㌁', '㌁'}, // 0x3301 ㌁
+	{0x3302, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌂', '㌂', '㌂', '㌂', '㌂'}, // 0x3302 ㌂
+	{0x3303, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌃', '㌃', '㌃', '㌃', '㌃'}, // 0x3303 ㌃
+	{0x3304, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌄', '㌄', '㌄', '㌄', '㌄'}, // 0x3304 ㌄
+	{0x3305, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌅', '㌅', '㌅', '㌅', '㌅'}, // 0x3305 ㌅
+	{0x3306, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌆', '㌆', '㌆', '㌆', '㌆'}, // 0x3306 ㌆
+	{0x3307, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌇', '㌇', '㌇', '㌇', '㌇'}, // 0x3307 ㌇
+	{0x3308, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌈', '㌈', '㌈', '㌈', '㌈'}, // 0x3308 ㌈
+	{0x3309, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌉', '㌉', '㌉', '㌉', '㌉'}, // 0x3309 ㌉
+	{0x330A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌊', '㌊', '㌊', '㌊', '㌊'}, // 0x330A ㌊
+	{0x330B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌋', '㌋', '㌋', '㌋', '㌋'}, // 0x330B ㌋
+	{0x330C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌌', '㌌', '㌌', '㌌', '㌌'}, // 0x330C ㌌
+	{0x330D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌍', '㌍', '㌍', '㌍', '㌍'}, // 0x330D ㌍
+	{0x330E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌎', '㌎', '㌎', '㌎', '㌎'}, // 0x330E ㌎
+	{0x330F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌏', '㌏', '㌏', '㌏', '㌏'}, // 0x330F ㌏
+	{0x3310, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌐', '㌐', '㌐', '㌐', '㌐'}, // 0x3310 ㌐
+	{0x3311, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌑', '㌑', '㌑', '㌑', '㌑'}, // 0x3311 ㌑
+	{0x3312, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌒', '㌒', '㌒', '㌒', '㌒'}, // 0x3312 ㌒
+	{0x3313, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌓', '㌓', '㌓', '㌓', '㌓'}, // 0x3313 ㌓
+	{0x3314, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌔', '㌔', '㌔', '㌔', '㌔'}, // 0x3314 ㌔
+	{0x3315, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌕', '㌕', '㌕', '㌕', '㌕'}, // 0x3315 ㌕
+	{0x3316, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌖', '㌖', '㌖', '㌖', '㌖'}, // 0x3316 ㌖
+	{0x3317, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌗', '㌗', '㌗', '㌗', '㌗'}, // 0x3317 ㌗
+	{0x3318, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌘', '㌘', '㌘', '㌘', '㌘'}, // 0x3318 ㌘
+	{0x3319, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌙', '㌙', '㌙', '㌙', '㌙'}, // 0x3319 ㌙
+	{0x331A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌚', '㌚', '㌚', '㌚', '㌚'}, // 0x331A ㌚
+	{0x331B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌛', '㌛', '㌛', '㌛', '㌛'}, // 0x331B ㌛
+	{0x331C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌜', '㌜', '㌜', '㌜', '㌜'}, // 0x331C ㌜
+	{0x331D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌝', '㌝', '㌝', '㌝', '㌝'}, // 0x331D ㌝
+	{0x331E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌞', '㌞', '㌞', '㌞', '㌞'}, // 0x331E ㌞
+	{0x331F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌟', '㌟', '㌟', '㌟', '㌟'}, // 0x331F ㌟
+	{0x3320, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌠', '㌠', '㌠', '㌠', '㌠'}, // 0x3320 ㌠
+	{0x3321, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌡', '㌡', '㌡', '㌡', '㌡'}, // 0x3321 ㌡
+	{0x3322, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌢', '㌢', '㌢', '㌢', '㌢'}, // 0x3322 ㌢
+	{0x3323, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌣', '㌣', '㌣', '㌣', '㌣'}, // 0x3323 ㌣
+	{0x3324, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌤', '㌤', '㌤', '㌤', '㌤'}, // 0x3324 ㌤
+	{0x3325, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌥', '㌥', '㌥', '㌥', '㌥'}, // 0x3325 ㌥
+	{0x3326, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌦', '㌦', '㌦', '㌦', '㌦'}, // 0x3326 ㌦
+	{0x3327, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌧', '㌧', '㌧', '㌧', '㌧'}, // 0x3327 ㌧
+	{0x3328, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌨', '㌨', '㌨', '㌨', '㌨'}, // 0x3328 ㌨
+	{0x3329, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌩', '㌩', '㌩', '㌩', '㌩'}, // 0x3329 ㌩
+	{0x332A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌪', '㌪', '㌪', '㌪', '㌪'}, // 0x332A ㌪
+	{0x332B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌫', '㌫', '㌫', '㌫', '㌫'}, // 0x332B ㌫
+	{0x332C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌬', '㌬', '㌬', '㌬', '㌬'}, // 0x332C ㌬
+	{0x332D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌭', '㌭', '㌭', '㌭', '㌭'}, // 0x332D ㌭
+	{0x332E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌮', '㌮', '㌮', '㌮', '㌮'}, // 0x332E ㌮
+	{0x332F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌯', '㌯', '㌯', '㌯', '㌯'}, // 0x332F ㌯
+	{0x3330, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌰', '㌰', '㌰', '㌰', '㌰'}, // 0x3330 ㌰
+	{0x3331, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌱', '㌱', '㌱', '㌱', '㌱'}, // 0x3331 ㌱
+	{0x3332, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌲', '㌲', '㌲', '㌲', '㌲'}, // 0x3332 ㌲
+	{0x3333, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌳', '㌳', '㌳', '㌳', '㌳'}, // 0x3333 ㌳
+	{0x3334, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌴', '㌴', '㌴', '㌴', '㌴'}, // 0x3334 ㌴
+	{0x3335, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌵', '㌵', '㌵', '㌵', '㌵'}, // 0x3335 ㌵
+	{0x3336, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌶', '㌶', '㌶', '㌶', '㌶'}, // 0x3336 ㌶
+	{0x3337, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌷', '㌷', '㌷', '㌷', '㌷'}, // 0x3337 ㌷
+	{0x3338, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌸', '㌸', '㌸', '㌸', '㌸'}, // 0x3338 ㌸
+	{0x3339, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌹', '㌹', '㌹', '㌹', '㌹'}, // 0x3339 ㌹
+	{0x333A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌺', '㌺', '㌺', '㌺', '㌺'}, // 0x333A ㌺
+	{0x333B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌻', '㌻', '㌻', '㌻', '㌻'}, // 0x333B ㌻
+	{0x333C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌼', '㌼', '㌼', '㌼', '㌼'}, // 0x333C ㌼
+	{0x333D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌽', '㌽', '㌽', '㌽', '㌽'}, // 0x333D ㌽
+	{0x333E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌾', '㌾', '㌾', '㌾', '㌾'}, // 0x333E ㌾
+	{0x333F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㌿', '㌿', '㌿', '㌿', '㌿'}, // 0x333F ㌿
+	{0x3340, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍀', '㍀', '㍀', '㍀', '㍀'}, // 0x3340 ㍀
+	{0x3341, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍁', '㍁', '㍁', '㍁', '㍁'}, // 0x3341 ㍁
+	{0x3342, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍂', '㍂', '㍂', '㍂', '㍂'}, // 0x3342 ㍂
+	{0x3343, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍃', '㍃', '㍃', '㍃', '㍃'}, // 0x3343 ㍃
+	{0x3344, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍄', '㍄', '㍄', '㍄', '㍄'}, // 0x3344 ㍄
+	{0x3345, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍅', '㍅', '㍅', '㍅', '㍅'}, // 0x3345 ㍅
+	{0x3346, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍆', '㍆', '㍆', '㍆', '㍆'}, // 0x3346 ㍆
+	{0x3347, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍇', '㍇', '㍇', '㍇', '㍇'}, // 0x3347 ㍇
+	{0x3348, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍈', '㍈', '㍈', '㍈', '㍈'}, // 0x3348 ㍈
+	{0x3349, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍉', '㍉', '㍉', '㍉', '㍉'}, // 0x3349 ㍉
+	{0x334A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍊', '㍊', '㍊', '㍊', '㍊'}, // 0x334A ㍊
+	{0x334B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍋', '㍋', '㍋', '㍋', '㍋'}, // 0x334B ㍋
+	{0x334C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍌', '㍌', '㍌', '㍌', '㍌'}, // 0x334C ㍌
+	{0x334D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍍', '㍍', '㍍', '㍍', '㍍'}, // 0x334D ㍍
+	{0x334E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍎', '㍎', '㍎', '㍎', '㍎'}, // 0x334E ㍎
+	{0x334F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍏', '㍏', '㍏', '㍏', '㍏'}, // 0x334F ㍏
+	{0x3350, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍐', '㍐', '㍐', '㍐', '㍐'}, // 0x3350 ㍐
+	{0x3351, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍑', '㍑', '㍑', '㍑', '㍑'}, // 0x3351 ㍑
+	{0x3352, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍒', '㍒', '㍒', '㍒', '㍒'}, // 0x3352 ㍒
+	{0x3353, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍓', '㍓', '㍓', '㍓', '㍓'}, // 0x3353 ㍓
+	{0x3354, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍔', '㍔', '㍔', '㍔', '㍔'}, // 0x3354 ㍔
+	{0x3355, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍕', '㍕', '㍕', '㍕', '㍕'}, // 0x3355 ㍕
+	{0x3356, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍖', '㍖', '㍖', '㍖', '㍖'}, // 0x3356 ㍖
+	{0x3357, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍗', '㍗', '㍗', '㍗', '㍗'}, // 0x3357 ㍗
+	{0x3358, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍘', '㍘', '㍘', '㍘', '㍘'}, // 0x3358 ㍘
+	{0x3359, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍙', '㍙', '㍙', '㍙', '㍙'}, // 0x3359 ㍙
+	{0x335A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍚', '㍚', '㍚', '㍚', '㍚'}, // 0x335A ㍚
+	{0x335B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍛', '㍛', '㍛', '㍛', '㍛'}, // 0x335B ㍛
+	{0x335C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍜', '㍜', '㍜', '㍜', '㍜'}, // 0x335C ㍜
+	{0x335D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍝', '㍝', '㍝', '㍝', '㍝'}, // 0x335D ㍝
+	{0x335E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍞', '㍞', '㍞', '㍞', '㍞'}, // 0x335E ㍞
+	{0x335F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍟', '㍟', '㍟', '㍟', '㍟'}, // 0x335F ㍟
+	{0x3360, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍠', '㍠', '㍠', '㍠', '㍠'}, // 0x3360 ㍠
+	{0x3361, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍡', '㍡', '㍡', '㍡', '㍡'}, // 0x3361 ㍡
+	{0x3362, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍢', '㍢', '㍢', '㍢', '㍢'}, // 0x3362 ㍢
+	{0x3363, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍣', '㍣', '㍣', '㍣', '㍣'}, // 0x3363 ㍣
+	{0x3364, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍤', '㍤', '㍤', '㍤', '㍤'}, // 0x3364 ㍤
+	{0x3365, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍥', '㍥', '㍥', '㍥', '㍥'}, // 0x3365 ㍥
+	{0x3366, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍦', '㍦', '㍦', '㍦', '㍦'}, // 0x3366 ㍦
+	{0x3367, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍧', '㍧', '㍧', '㍧', '㍧'}, // 0x3367 ㍧
+	{0x3368, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍨', '㍨', '㍨', '㍨', '㍨'}, // 0x3368 ㍨
+	{0x3369, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍩', '㍩', '㍩', '㍩', '㍩'}, // 0x3369 ㍩
+	{0x336A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍪', '㍪', '㍪', '㍪', '㍪'}, // 0x336A ㍪
+	{0x336B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍫', '㍫', '㍫', '㍫', '㍫'}, // 0x336B ㍫
+	{0x336C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍬', '㍬', '㍬', '㍬', '㍬'}, // 0x336C ㍬
+	{0x336D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍭', '㍭', '㍭', '㍭', '㍭'}, // 0x336D ㍭
+	{0x336E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍮', '㍮', '㍮', '㍮', '㍮'}, // 0x336E ㍮
+	{0x336F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍯', '㍯', '㍯', '㍯', '㍯'}, // 0x336F ㍯
+	{0x3370, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍰', '㍰', '㍰', '㍰', '㍰'}, // 0x3370 ㍰
+	{0x3371, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍱', '㍱', '㍱', '㍱', '㍱'}, // 0x3371 ㍱
+	{0x3372, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍲', '㍲', '㍲', '㍲', '㍲'}, // 0x3372 ㍲
+	{0x3373, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍳', '㍳', '㍳', '㍳', '㍳'}, // 0x3373 ㍳
+	{0x3374, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍴', '㍴', '㍴', '㍴', '㍴'}, // 0x3374 ㍴
+	{0x3375, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍵', '㍵', '㍵', '㍵', '㍵'}, // 0x3375 ㍵
+	{0x3376, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍶', '㍶', '㍶', '㍶', '㍶'}, // 0x3376 ㍶
+	{0x3377, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍷', '㍷', '㍷', '㍷', '㍷'}, // 0x3377 ㍷
+	{0x3378, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍸', '㍸', '㍸', '㍸', '㍸'}, // 0x3378 ㍸
+	{0x3379, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍹', '㍹', '㍹', '㍹', '㍹'}, // 0x3379 ㍹
+	{0x337A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍺', '㍺', '㍺', '㍺', '㍺'}, // 0x337A ㍺
+	{0x337B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍻', '㍻', '㍻', '㍻', '㍻'}, // 0x337B ㍻
+	{0x337C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍼', '㍼', '㍼', '㍼', '㍼'}, // 0x337C ㍼
+	{0x337D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍽', '㍽', '㍽', '㍽', '㍽'}, // 0x337D ㍽
+	{0x337E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍾', '㍾', '㍾', '㍾', '㍾'}, // 0x337E ㍾
+	{0x337F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㍿', '㍿', '㍿', '㍿', '㍿'}, // 0x337F ㍿
+	{0x3380, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎀', '㎀', '㎀', '㎀', '㎀'}, // 0x3380 ㎀
+	{0x3381, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎁', '㎁', '㎁', '㎁', '㎁'}, // 0x3381 ㎁
+	{0x3382, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎂', '㎂', '㎂', '㎂', '㎂'}, // 0x3382 ㎂
+	{0x3383, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎃', '㎃', '㎃', '㎃', '㎃'}, // 0x3383 ㎃
+	{0x3384, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎄', '㎄', '㎄', '㎄', '㎄'}, // 0x3384 ㎄
+	{0x3385, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎅', '㎅', '㎅', '㎅', '㎅'}, // 0x3385 ㎅
+	{0x3386, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎆', '㎆', '㎆', '㎆', '㎆'}, // 0x3386 ㎆
+	{0x3387, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎇', '㎇', '㎇', '㎇', '㎇'}, // 0x3387 ㎇
+	{0x3388, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎈', '㎈', '㎈', '㎈', '㎈'}, // 0x3388 ㎈
+	{0x3389, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎉', '㎉', '㎉', '㎉', '㎉'}, // 0x3389 ㎉
+	{0x338A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎊', '㎊', '㎊', '㎊', '㎊'}, // 0x338A ㎊
+	{0x338B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎋', '㎋', '㎋', '㎋', '㎋'}, // 0x338B ㎋
+	{0x338C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎌', '㎌', '㎌', '㎌', '㎌'}, // 0x338C ㎌
+	{0x338D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎍', '㎍', '㎍', '㎍', '㎍'}, // 0x338D ㎍
+	{0x338E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎎', '㎎', '㎎', '㎎', '㎎'}, // 0x338E ㎎
+	{0x338F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎏', '㎏', '㎏', '㎏', '㎏'}, // 0x338F ㎏
+	{0x3390, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎐', '㎐', '㎐', '㎐', '㎐'}, // 0x3390 ㎐
+	{0x3391, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎑', '㎑', '㎑', '㎑', '㎑'}, // 0x3391 ㎑
+	{0x3392, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎒', '㎒', '㎒', '㎒', '㎒'}, // 0x3392 ㎒
+	{0x3393, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎓', '㎓', '㎓', '㎓', '㎓'}, // 0x3393 ㎓
+	{0x3394, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎔', '㎔', '㎔', '㎔', '㎔'}, // 0x3394 ㎔
+	{0x3395, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎕', '㎕', '㎕', '㎕', '㎕'}, // 0x3395 ㎕
+	{0x3396, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎖', '㎖', '㎖', '㎖', '㎖'}, // 0x3396 ㎖
+	{0x3397, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎗', '㎗', '㎗', '㎗', '㎗'}, // 0x3397 ㎗
+	{0x3398, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎘', '㎘', '㎘', '㎘', '㎘'}, // 0x3398 ㎘
+	{0x3399, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎙', '㎙', '㎙', '㎙', '㎙'}, // 0x3399 ㎙
+	{0x339A, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎚', '㎚', '㎚', '㎚', '㎚'}, // 0x339A ㎚
+	{0x339B, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎛', '㎛', '㎛', '㎛', '㎛'}, // 0x339B ㎛
+	{0x339C, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎜', '㎜', '㎜', '㎜', '㎜'}, // 0x339C ㎜
+	{0x339D, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎝', '㎝', '㎝', '㎝', '㎝'}, // 0x339D ㎝
+	{0x339E, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎞', '㎞', '㎞', '㎞', '㎞'}, // 0x339E ㎞
+	{0x339F, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎟', '㎟', '㎟', '㎟', '㎟'}, // 0x339F ㎟
+	{0x33A0, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎠', '㎠', '㎠', '㎠', '㎠'}, // 0x33A0 ㎠
+	{0x33A1, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎡', '㎡', '㎡', '㎡', '㎡'}, // 0x33A1 ㎡
+	{0x33A2, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎢', '㎢', '㎢', '㎢', '㎢'}, // 0x33A2 ㎢
+	{0x33A3, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎣', '㎣', '㎣', '㎣', '㎣'}, // 0x33A3 ㎣
+	{0x33A4, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎤', '㎤', '㎤', '㎤', '㎤'}, // 0x33A4 ㎤
+	{0x33A5, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎥', '㎥', '㎥', '㎥', '㎥'}, // 0x33A5 ㎥
+	{0x33A6, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎦', '㎦', '㎦', '㎦', '㎦'}, // 0x33A6 ㎦
+	{0x33A7, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎧', '㎧', '㎧', '㎧', '㎧'}, // 0x33A7 ㎧
+	{0x33A8, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎨', '㎨', '㎨', '㎨', '㎨'}, // 0x33A8 ㎨
+	{0x33A9, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎩', '㎩', '㎩', '㎩', '㎩'}, // 0x33A9 ㎩
+	{0x33AA, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎪', '㎪', '㎪', '㎪', '㎪'}, // 0x33AA ㎪
+	{0x33AB, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎫', '㎫', '㎫', '㎫', '㎫'}, // 0x33AB ㎫
+	{0x33AC, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎬', '㎬', '㎬', '㎬', '㎬'}, // 0x33AC ㎬
+	{0x33AD, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎭', '㎭', '㎭', '㎭', '㎭'}, // 0x33AD ㎭
+	{0x33AE, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎮', '㎮', '㎮', '㎮', '㎮'}, // 0x33AE ㎮
+	{0x33AF, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎯', '㎯', '㎯', '㎯', '㎯'}, // 0x33AF ㎯
+	{0x33B0, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎰', '㎰', '㎰', '㎰', '㎰'}, // 0x33B0 ㎰
+	{0x33B1, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎱', '㎱', '㎱', '㎱', '㎱'}, // 0x33B1 ㎱
+	{0x33B2, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎲', '㎲', '㎲', '㎲', '㎲'}, // 0x33B2 ㎲
+	{0x33B3, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎳', '㎳', '㎳', '㎳', '㎳'}, // 0x33B3 ㎳
+	{0x33B4, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎴', '㎴', '㎴', '㎴', '㎴'}, // 0x33B4 ㎴
+	{0x33B5, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎵', '㎵', '㎵', '㎵', '㎵'}, // 0x33B5 ㎵
+	{0x33B6, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎶', '㎶', '㎶', '㎶', '㎶'}, // 0x33B6 ㎶
+	{0x33B7, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎷', '㎷', '㎷', '㎷', '㎷'}, // 0x33B7 ㎷
+	{0x33B8, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎸', '㎸', '㎸', '㎸', '㎸'}, // 0x33B8 ㎸
+	{0x33B9, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎹', '㎹', '㎹', '㎹', '㎹'}, // 0x33B9 ㎹
+	{0x33BA, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎺', '㎺', '㎺', '㎺', '㎺'}, // 0x33BA ㎺
+	{0x33BB, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎻', '㎻', '㎻', '㎻', '㎻'}, // 0x33BB ㎻
+	{0x33BC, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎼', '㎼', '㎼', '㎼', '㎼'}, // 0x33BC ㎼
+	{0x33BD, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎽', '㎽', '㎽', '㎽', '㎽'}, // 0x33BD ㎽
+	{0x33BE, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎾', '㎾', '㎾', '㎾', '㎾'}, // 0x33BE ㎾
+	{0x33BF, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㎿', '㎿', '㎿', '㎿', '㎿'}, // 0x33BF ㎿
+	{0x33C0, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏀', '㏀', '㏀', '㏀', '㏀'}, // 0x33C0 ㏀
+	{0x33C1, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏁', '㏁', '㏁', '㏁', '㏁'}, // 0x33C1 ㏁
+	{0x33C2, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏂', '㏂', '㏂', '㏂', '㏂'}, // 0x33C2 ㏂
+	{0x33C3, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏃', '㏃', '㏃', '㏃', '㏃'}, // 0x33C3 ㏃
+	{0x33C4, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏄', '㏄', '㏄', '㏄', '㏄'}, // 0x33C4 ㏄
+	{0x33C5, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏅', '㏅', '㏅', '㏅', '㏅'}, // 0x33C5 ㏅
+	{0x33C6, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏆', '㏆', '㏆', '㏆', '㏆'}, // 0x33C6 ㏆
+	{0x33C7, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏇', '㏇', '㏇', '㏇', '㏇'}, // 0x33C7 ㏇
+	{0x33C8, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏈', '㏈', '㏈', '㏈', '㏈'}, // 0x33C8 ㏈
+	{0x33C9, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏉', '㏉', '㏉', '㏉', '㏉'}, // 0x33C9 ㏉
+	{0x33CA, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏊', '㏊', '㏊', '㏊', '㏊'}, // 0x33CA ㏊
+	{0x33CB, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏋', '㏋', '㏋', '㏋', '㏋'}, // 0x33CB ㏋
+	{0x33CC, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏌', '㏌', '㏌', '㏌', '㏌'}, // 0x33CC ㏌
+	{0x33CD, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏍', '㏍', '㏍', '㏍', '㏍'}, // 0x33CD ㏍
+	{0x33CE, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏎', '㏎', '㏎', '㏎', '㏎'}, // 0x33CE ㏎
+	{0x33CF, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏏', '㏏', '㏏', '㏏', '㏏'}, // 0x33CF ㏏
+	{0x33D0, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏐', '㏐', '㏐', '㏐', '㏐'}, // 0x33D0 ㏐
+	{0x33D1, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏑', '㏑', '㏑', '㏑', '㏑'}, // 0x33D1 ㏑
+	{0x33D2, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏒', '㏒', '㏒', '㏒', '㏒'}, // 0x33D2 ㏒
+	{0x33D3, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏓', '㏓', '㏓', '㏓', '㏓'}, // 0x33D3 ㏓
+	{0x33D4, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏔', '㏔', '㏔', '㏔', '㏔'}, // 0x33D4 ㏔
+	{0x33D5, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏕', '㏕', '㏕', '㏕', '㏕'}, // 0x33D5 ㏕
+	{0x33D6, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏖', '㏖', '㏖', '㏖', '㏖'}, // 0x33D6 ㏖
+	{0x33D7, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏗', '㏗', '㏗', '㏗', '㏗'}, // 0x33D7 ㏗
+	{0x33D8, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏘', '㏘', '㏘', '㏘', '㏘'}, // 0x33D8 ㏘
+	{0x33D9, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏙', '㏙', '㏙', '㏙', '㏙'}, // 0x33D9 ㏙
+	{0x33DA, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏚', '㏚', '㏚', '㏚', '㏚'}, // 0x33DA ㏚
+	{0x33DB, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏛', '㏛', '㏛', '㏛', '㏛'}, // 0x33DB ㏛
+	{0x33DC, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏜', '㏜', '㏜', '㏜', '㏜'}, // 0x33DC ㏜
+	{0x33DD, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏝', '㏝', '㏝', '㏝', '㏝'}, // 0x33DD ㏝
+	{0x33DE, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏞', '㏞', '㏞', '㏞', '㏞'}, // 0x33DE ㏞
+	{0x33DF, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏟', '㏟', '㏟', '㏟', '㏟'}, // 0x33DF ㏟
+	{0x33E0, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏠', '㏠', '㏠', '㏠', '㏠'}, // 0x33E0 ㏠
+	{0x33E1, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏡', '㏡', '㏡', '㏡', '㏡'}, // 0x33E1 ㏡
+	{0x33E2, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏢', '㏢', '㏢', '㏢', '㏢'}, // 0x33E2 ㏢
+	{0x33E3, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏣', '㏣', '㏣', '㏣', '㏣'}, // 0x33E3 ㏣
+	{0x33E4, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏤', '㏤', '㏤', '㏤', '㏤'}, // 0x33E4 ㏤
+	{0x33E5, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏥', '㏥', '㏥', '㏥', '㏥'}, // 0x33E5 ㏥
+	{0x33E6, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏦', '㏦', '㏦', '㏦', '㏦'}, // 0x33E6 ㏦
+	{0x33E7, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏧', '㏧', '㏧', '㏧', '㏧'}, // 0x33E7 ㏧
+	{0x33E8, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏨', '㏨', '㏨', '㏨', '㏨'}, // 0x33E8 ㏨
+	{0x33E9, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏩', '㏩', '㏩', '㏩', '㏩'}, // 0x33E9 ㏩
+	{0x33EA, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏪', '㏪', '㏪', '㏪', '㏪'}, // 0x33EA ㏪
+	{0x33EB, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏫', '㏫', '㏫', '㏫', '㏫'}, // 0x33EB ㏫
+	{0x33EC, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏬', '㏬', '㏬', '㏬', '㏬'}, // 0x33EC ㏬
+	{0x33ED, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏭', '㏭', '㏭', '㏭', '㏭'}, // 0x33ED ㏭
+	{0x33EE, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏮', '㏮', '㏮', '㏮', '㏮'}, // 0x33EE ㏮
+	{0x33EF, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏯', '㏯', '㏯', '㏯', '㏯'}, // 0x33EF ㏯
+	{0x33F0, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏰', '㏰', '㏰', '㏰', '㏰'}, // 0x33F0 ㏰
+	{0x33F1, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏱', '㏱', '㏱', '㏱', '㏱'}, // 0x33F1 ㏱
+	{0x33F2, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏲', '㏲', '㏲', '㏲', '㏲'}, // 0x33F2 ㏲
+	{0x33F3, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏳', '㏳', '㏳', '㏳', '㏳'}, // 0x33F3 ㏳
+	{0x33F4, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏴', '㏴', '㏴', '㏴', '㏴'}, // 0x33F4 ㏴
+	{0x33F5, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏵', '㏵', '㏵', '㏵', '㏵'}, // 0x33F5 ㏵
+	{0x33F6, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏶', '㏶', '㏶', '㏶', '㏶'}, // 0x33F6 ㏶
+	{0x33F7, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏷', '㏷', '㏷', '㏷', '㏷'}, // 0x33F7 ㏷
+	{0x33F8, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏸', '㏸', '㏸', '㏸', '㏸'}, // 0x33F8 ㏸
+	{0x33F9, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏹', '㏹', '㏹', '㏹', '㏹'}, // 0x33F9 ㏹
+	{0x33FA, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏺', '㏺', '㏺', '㏺', '㏺'}, // 0x33FA ㏺
+	{0x33FB, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏻', '㏻', '㏻', '㏻', '㏻'}, // 0x33FB ㏻
+	{0x33FC, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏼', '㏼', '㏼', '㏼', '㏼'}, // 0x33FC ㏼
+	{0x33FD, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏽', '㏽', '㏽', '㏽', '㏽'}, // 0x33FD ㏽
+	{0x33FE, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏾', '㏾', '㏾', '㏾', '㏾'}, // 0x33FE ㏾
+	{0x33FF, ctCompat, ccUndefined, cwUndefined, vcUndefined, '㏿', '㏿', '㏿', '㏿', '㏿'}, // 0x33FF ㏿
 }
 
 var widthFirst rune = 0xFF00
 var widthLast rune = 0xFF9F
 var widthTable = unichars{
-	{0xFF00, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\uff00', '\uff00', '\uff00', '\uff00'}, // 0xFF00 ＀
-	{0xFF01, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '！', '!', '！', '！'},                        // 0xFF01 ！
-	{0xFF02, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＂', '"', '＂', '＂'},                        // 0xFF02 ＂
-	{0xFF03, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＃', '#', '＃', '＃'},                        // 0xFF03 ＃
-	{0xFF04, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＄', '$', '＄', '＄'},                        // 0xFF04 ＄
-	{0xFF05, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '％', '%', '％', '％'},                        // 0xFF05 ％
-	{0xFF06, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＆', '&', '＆', '＆'},                        // 0xFF06 ＆
-	{0xFF07, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＇', '\'', '＇', '＇'},                       // 0xFF07 ＇
-	{0xFF08, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '（', '(', '（', '（'},                        // 0xFF08 （
-	{0xFF09, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '）', ')', '）', '）'},                        // 0xFF09 ）
-	{0xFF0A, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＊', '*', '＊', '＊'},                        // 0xFF0A ＊
-	{0xFF0B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＋', '+', '＋', '＋'},                        // 0xFF0B ＋
-	{0xFF0C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '，', ',', '，', '，'},                        // 0xFF0C ，
-	{0xFF0D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '－', '-', '－', '－'},                        // 0xFF0D －
-	{0xFF0E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '．', '.', '．', '．'},                        // 0xFF0E ．
-	{0xFF0F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '／', '/', '／', '／'},                        // 0xFF0F ／
-	{0xFF10, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '０', '0', '０', '０'},                         // 0xFF10 ０
-	{0xFF11, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '１', '1', '１', '１'},                         // 0xFF11 １
-	{0xFF12, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '２', '2', '２', '２'},                         // 0xFF12 ２
-	{0xFF13, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '３', '3', '３', '３'},                         // 0xFF13 ３
-	{0xFF14, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '４', '4', '４', '４'},                         // 0xFF14 ４
-	{0xFF15, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '５', '5', '５', '５'},                         // 0xFF15 ５
-	{0xFF16, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '６', '6', '６', '６'},                         // 0xFF16 ６
-	{0xFF17, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '７', '7', '７', '７'},                         // 0xFF17 ７
-	{0xFF18, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '８', '8', '８', '８'},                         // 0xFF18 ８
-	{0xFF19, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '９', '9', '９', '９'},                         // 0xFF19 ９
-	{0xFF1A, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '：', ':', '：', '：'},                        // 0xFF1A ：
-	{0xFF1B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '；', ';', '；', '；'},                        // 0xFF1B ；
-	{0xFF1C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＜', '<', '＜', '＜'},                        // 0xFF1C ＜
-	{0xFF1D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＝', '=', '＝', '＝'},                        // 0xFF1D ＝
-	{0xFF1E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＞', '>', '＞', '＞'},                        // 0xFF1E ＞
-	{0xFF1F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '？', '?', '？', '？'},                        // 0xFF1F ？
-	{0xFF20, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＠', '@', '＠', '＠'},                        // 0xFF20 ＠
-	{0xFF21, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ａ', 'A', 'Ａ', 'Ａ'},                            // 0xFF21 Ａ
-	{0xFF22, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｂ', 'B', 'Ｂ', 'Ｂ'},                            // 0xFF22 Ｂ
-	{0xFF23, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｃ', 'C', 'Ｃ', 'Ｃ'},                            // 0xFF23 Ｃ
-	{0xFF24, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｄ', 'D', 'Ｄ', 'Ｄ'},                            // 0xFF24 Ｄ
-	{0xFF25, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｅ', 'E', 'Ｅ', 'Ｅ'},                            // 0xFF25 Ｅ
-	{0xFF26, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｆ', 'F', 'Ｆ', 'Ｆ'},                            // 0xFF26 Ｆ
-	{0xFF27, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｇ', 'G', 'Ｇ', 'Ｇ'},                            // 0xFF27 Ｇ
-	{0xFF28, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｈ', 'H', 'Ｈ', 'Ｈ'},                            // 0xFF28 Ｈ
-	{0xFF29, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｉ', 'I', 'Ｉ', 'Ｉ'},                            // 0xFF29 Ｉ
-	{0xFF2A, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｊ', 'J', 'Ｊ', 'Ｊ'},                            // 0xFF2A Ｊ
-	{0xFF2B, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｋ', 'K', 'Ｋ', 'Ｋ'},                            // 0xFF2B Ｋ
-	{0xFF2C, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｌ', 'L', 'Ｌ', 'Ｌ'},                            // 0xFF2C Ｌ
-	{0xFF2D, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｍ', 'M', 'Ｍ', 'Ｍ'},                            // 0xFF2D Ｍ
-	{0xFF2E, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｎ', 'N', 'Ｎ', 'Ｎ'},                            // 0xFF2E Ｎ
-	{0xFF2F, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｏ', 'O', 'Ｏ', 'Ｏ'},                            // 0xFF2F Ｏ
-	{0xFF30, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｐ', 'P', 'Ｐ', 'Ｐ'},                            // 0xFF30 Ｐ
-	{0xFF31, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｑ', 'Q', 'Ｑ', 'Ｑ'},                            // 0xFF31 Ｑ
-	{0xFF32, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｒ', 'R', 'Ｒ', 'Ｒ'},                            // 0xFF32 Ｒ
-	{0xFF33, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｓ', 'S', 'Ｓ', 'Ｓ'},                            // 0xFF33 Ｓ
-	{0xFF34, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｔ', 'T', 'Ｔ', 'Ｔ'},                            // 0xFF34 Ｔ
-	{0xFF35, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｕ', 'U', 'Ｕ', 'Ｕ'},                            // 0xFF35 Ｕ
-	{0xFF36, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｖ', 'V', 'Ｖ', 'Ｖ'},                            // 0xFF36 Ｖ
-	{0xFF37, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｗ', 'W', 'Ｗ', 'Ｗ'},                            // 0xFF37 Ｗ
-	{0xFF38, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｘ', 'X', 'Ｘ', 'Ｘ'},                            // 0xFF38 Ｘ
-	{0xFF39, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｙ', 'Y', 'Ｙ', 'Ｙ'},                            // 0xFF39 Ｙ
-	{0xFF3A, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｚ', 'Z', 'Ｚ', 'Ｚ'},                            // 0xFF3A Ｚ
-	{0xFF3B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '［', '[', '［', '［'},                        // 0xFF3B ［
-	{0xFF3C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＼', '\\', '＼', '＼'},                       // 0xFF3C ＼
-	{0xFF3D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '］', ']', '］', '］'},                        // 0xFF3D ］
-	{0xFF3E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＾', '^', '＾', '＾'},                        // 0xFF3E ＾
-	{0xFF3F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＿', '_', '＿', '＿'},                        // 0xFF3F ＿
-	{0xFF40, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｀', '`', '｀', '｀'},                        // 0xFF40 ｀
-	{0xFF41, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ａ', 'a', 'ａ', 'ａ'},                            // 0xFF41 ａ
-	{0xFF42, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｂ', 'b', 'ｂ', 'ｂ'},                            // 0xFF42 ｂ
-	{0xFF43, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｃ', 'c', 'ｃ', 'ｃ'},                            // 0xFF43 ｃ
-	{0xFF44, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｄ', 'd', 'ｄ', 'ｄ'},                            // 0xFF44 ｄ
-	{0xFF45, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｅ', 'e', 'ｅ', 'ｅ'},                            // 0xFF45 ｅ
-	{0xFF46, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｆ', 'f', 'ｆ', 'ｆ'},                            // 0xFF46 ｆ
-	{0xFF47, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｇ', 'g', 'ｇ', 'ｇ'},                            // 0xFF47 ｇ
-	{0xFF48, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｈ', 'h', 'ｈ', 'ｈ'},                            // 0xFF48 ｈ
-	{0xFF49, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｉ', 'i', 'ｉ', 'ｉ'},                            // 0xFF49 ｉ
-	{0xFF4A, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｊ', 'j', 'ｊ', 'ｊ'},                            // 0xFF4A ｊ
-	{0xFF4B, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｋ', 'k', 'ｋ', 'ｋ'},                            // 0xFF4B ｋ
-	{0xFF4C, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｌ', 'l', 'ｌ', 'ｌ'},                            // 0xFF4C ｌ
-	{0xFF4D, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｍ', 'm', 'ｍ', 'ｍ'},                            // 0xFF4D ｍ
-	{0xFF4E, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｎ', 'n', 'ｎ', 'ｎ'},                            // 0xFF4E ｎ
-	{0xFF4F, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｏ', 'o', 'ｏ', 'ｏ'},                            // 0xFF4F ｏ
-	{0xFF50, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｐ', 'p', 'ｐ', 'ｐ'},                            // 0xFF50 ｐ
-	{0xFF51, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｑ', 'q', 'ｑ', 'ｑ'},                            // 0xFF51 ｑ
-	{0xFF52, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｒ', 'r', 'ｒ', 'ｒ'},                            // 0xFF52 ｒ
-	{0xFF53, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｓ', 's', 'ｓ', 'ｓ'},                            // 0xFF53 ｓ
-	{0xFF54, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｔ', 't', 'ｔ', 'ｔ'},                            // 0xFF54 ｔ
-	{0xFF55, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｕ', 'u', 'ｕ', 'ｕ'},                            // 0xFF55 ｕ
-	{0xFF56, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｖ', 'v', 'ｖ', 'ｖ'},                            // 0xFF56 ｖ
-	{0xFF57, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｗ', 'w', 'ｗ', 'ｗ'},                            // 0xFF57 ｗ
-	{0xFF58, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｘ', 'x', 'ｘ', 'ｘ'},                            // 0xFF58 ｘ
-	{0xFF59, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｙ', 'y', 'ｙ', 'ｙ'},                            // 0xFF59 ｙ
-	{0xFF5A, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｚ', 'z', 'ｚ', 'ｚ'},                            // 0xFF5A ｚ
-	{0xFF5B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｛', '{', '｛', '｛'},                        // 0xFF5B ｛
-	{0xFF5C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｜', '|', '｜', '｜'},                        // 0xFF5C ｜
-	{0xFF5D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｝', '}', '｝', '｝'},                        // 0xFF5D ｝
-	{0xFF5E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '～', '~', '～', '～'},                        // 0xFF5E ～
-	{0xFF5F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｟', '｟', '｟', '｟'},                        // 0xFF5F ｟
-	{0xFF60, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｠', '｠', '｠', '｠'},                        // 0xFF60 ｠
-	{0xFF61, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '｡', '。', '｡', '｡'},                       // 0xFF61 ｡
-	{0xFF62, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '｢', '「', '｢', '｢'},                       // 0xFF62 ｢
-	{0xFF63, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '｣', '」', '｣', '｣'},                       // 0xFF63 ｣
-	{0xFF64, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '､', '、', '､', '､'},                       // 0xFF64 ､
-	{0xFF65, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '･', '・', '･', '･'},                       // 0xFF65 ･
-	{0xFF66, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'を', 'ヲ', 'ｦ', 'ｦ'},                        // 0xFF66 ｦ
-	{0xFF67, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぁ', 'ァ', 'ｧ', 'ｧ'},                        // 0xFF67 ｧ
-	{0xFF68, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぃ', 'ィ', 'ｨ', 'ｨ'},                        // 0xFF68 ｨ
-	{0xFF69, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぅ', 'ゥ', 'ｩ', 'ｩ'},                        // 0xFF69 ｩ
-	{0xFF6A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぇ', 'ェ', 'ｪ', 'ｪ'},                        // 0xFF6A ｪ
-	{0xFF6B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぉ', 'ォ', 'ｫ', 'ｫ'},                        // 0xFF6B ｫ
-	{0xFF6C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ゃ', 'ャ', 'ｬ', 'ｬ'},                        // 0xFF6C ｬ
-	{0xFF6D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ゅ', 'ュ', 'ｭ', 'ｭ'},                        // 0xFF6D ｭ
-	{0xFF6E, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ょ', 'ョ', 'ｮ', 'ｮ'},                        // 0xFF6E ｮ
-	{0xFF6F, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'っ', 'ッ', 'ｯ', 'ｯ'},                        // 0xFF6F ｯ
-	{0xFF70, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ｰ', 'ー', 'ｰ', 'ｰ'},                        // 0xFF70 ｰ
-	{0xFF71, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'あ', 'ア', 'ｱ', 'ｱ'},                        // 0xFF71 ｱ
-	{0xFF72, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'い', 'イ', 'ｲ', 'ｲ'},                        // 0xFF72 ｲ
-	{0xFF73, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'う', 'ウ', 'ｳ', 'ｳ'},                        // 0xFF73 ｳ
-	{0xFF74, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'え', 'エ', 'ｴ', 'ｴ'},                        // 0xFF74 ｴ
-	{0xFF75, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'お', 'オ', 'ｵ', 'ｵ'},                        // 0xFF75 ｵ
-	{0xFF76, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'か', 'カ', 'ｶ', 'ｶ'},                        // 0xFF76 ｶ
-	{0xFF77, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'き', 'キ', 'ｷ', 'ｷ'},                        // 0xFF77 ｷ
-	{0xFF78, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'く', 'ク', 'ｸ', 'ｸ'},                        // 0xFF78 ｸ
-	{0xFF79, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'け', 'ケ', 'ｹ', 'ｹ'},                        // 0xFF79 ｹ
-	{0xFF7A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'こ', 'コ', 'ｺ', 'ｺ'},                        // 0xFF7A ｺ
-	{0xFF7B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'さ', 'サ', 'ｻ', 'ｻ'},                        // 0xFF7B ｻ
-	{0xFF7C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'し', 'シ', 'ｼ', 'ｼ'},                        // 0xFF7C ｼ
-	{0xFF7D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'す', 'ス', 'ｽ', 'ｽ'},                        // 0xFF7D ｽ
-	{0xFF7E, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'せ', 'セ', 'ｾ', 'ｾ'},                        // 0xFF7E ｾ
-	{0xFF7F, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'そ', 'ソ', 'ｿ', 'ｿ'},                        // 0xFF7F ｿ
-	{0xFF80, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'た', 'タ', 'ﾀ', 'ﾀ'},                        // 0xFF80 ﾀ
-	{0xFF81, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ち', 'チ', 'ﾁ', 'ﾁ'},                        // 0xFF81 ﾁ
-	{0xFF82, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'つ', 'ツ', 'ﾂ', 'ﾂ'},                        // 0xFF82 ﾂ
-	{0xFF83, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'て', 'テ', 'ﾃ', 'ﾃ'},                        // 0xFF83 ﾃ
-	{0xFF84, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'と', 'ト', 'ﾄ', 'ﾄ'},                        // 0xFF84 ﾄ
-	{0xFF85, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'な', 'ナ', 'ﾅ', 'ﾅ'},                        // 0xFF85 ﾅ
-	{0xFF86, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'に', 'ニ', 'ﾆ', 'ﾆ'},                        // 0xFF86 ﾆ
-	{0xFF87, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぬ', 'ヌ', 'ﾇ', 'ﾇ'},                        // 0xFF87 ﾇ
-	{0xFF88, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ね', 'ネ', 'ﾈ', 'ﾈ'},                        // 0xFF88 ﾈ
-	{0xFF89, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'の', 'ノ', 'ﾉ', 'ﾉ'},                        // 0xFF89 ﾉ
-	{0xFF8A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'は', 'ハ', 'ﾊ', 'ﾊ'},                        // 0xFF8A ﾊ
-	{0xFF8B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ひ', 'ヒ', 'ﾋ', 'ﾋ'},                        // 0xFF8B ﾋ
-	{0xFF8C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ふ', 'フ', 'ﾌ', 'ﾌ'},                        // 0xFF8C ﾌ
-	{0xFF8D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'へ', 'ヘ', 'ﾍ', 'ﾍ'},                        // 0xFF8D ﾍ
-	{0xFF8E, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ほ', 'ホ', 'ﾎ', 'ﾎ'},                        // 0xFF8E ﾎ
-	{0xFF8F, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ま', 'マ', 'ﾏ', 'ﾏ'},                        // 0xFF8F ﾏ
-	{0xFF90, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'み', 'ミ', 'ﾐ', 'ﾐ'},                        // 0xFF90 ﾐ
-	{0xFF91, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'む', 'ム', 'ﾑ', 'ﾑ'},                        // 0xFF91 ﾑ
-	{0xFF92, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'め', 'メ', 'ﾒ', 'ﾒ'},                        // 0xFF92 ﾒ
-	{0xFF93, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'も', 'モ', 'ﾓ', 'ﾓ'},                        // 0xFF93 ﾓ
-	{0xFF94, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'や', 'ヤ', 'ﾔ', 'ﾔ'},                        // 0xFF94 ﾔ
-	{0xFF95, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ゆ', 'ユ', 'ﾕ', 'ﾕ'},                        // 0xFF95 ﾕ
-	{0xFF96, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'よ', 'ヨ', 'ﾖ', 'ﾖ'},                        // 0xFF96 ﾖ
-	{0xFF97, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ら', 'ラ', 'ﾗ', 'ﾗ'},                        // 0xFF97 ﾗ
-	{0xFF98, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'り', 'リ', 'ﾘ', 'ﾘ'},                        // 0xFF98 ﾘ
-	{0xFF99, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'る', 'ル', 'ﾙ', 'ﾙ'},                        // 0xFF99 ﾙ
-	{0xFF9A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'れ', 'レ', 'ﾚ', 'ﾚ'},                        // 0xFF9A ﾚ
-	{0xFF9B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ろ', 'ロ', 'ﾛ', 'ﾛ'},                        // 0xFF9B ﾛ
-	{0xFF9C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'わ', 'ワ', 'ﾜ', 'ﾜ'},                        // 0xFF9C ﾜ
-	{0xFF9D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ん', 'ン', 'ﾝ', 'ﾝ'},                        // 0xFF9D ﾝ
-	{0xFF9E, ctKanaVom, ccLegacy, cwNarrow, vcUndefined, '゙', '゛', 'ﾞ', 'ﾞ'},                             // 0xFF9E ﾞ
-	{0xFF9F, ctKanaVom, ccLegacy, cwNarrow, vcUndefined, '゚', '゜', 'ﾟ', 'ﾟ'},                             // 0xFF9F ﾟ
+	{0xFF00, ctUndefined, ccUndefined, cwUndefined, vcUndefined, '\uff00', '\uff00', '\uff00', '\uff00', '\uff00'}, // 0xFF00 ＀
+	{0xFF01, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '！', '!', '！', '！', '！'},                             // 0xFF01 ！
+	{0xFF02, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＂', '"', '＂', '＂', '＂'},                             // 0xFF02 ＂
+	{0xFF03, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＃', '#', '＃', '＃', '＃'},                             // 0xFF03 ＃
+	{0xFF04, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＄', '$', '＄', '＄', '＄'},                             // 0xFF04 ＄
+	{0xFF05, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '％', '%', '％', '％', '％'},                             // 0xFF05 ％
+	{0xFF06, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＆', '&', '＆', '＆', '＆'},                             // 0xFF06 ＆
+	{0xFF07, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＇', '\'', '＇', '＇', '＇'},                            // 0xFF07 ＇
+	{0xFF08, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '（', '(', '（', '（', '（'},                             // 0xFF08 （
+	{0xFF09, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '）', ')', '）', '）', '）'},                             // 0xFF09 ）
+	{0xFF0A, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＊', '*', '＊', '＊', '＊'},                             // 0xFF0A ＊
+	{0xFF0B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＋', '+', '＋', '＋', '＋'},                             // 0xFF0B ＋
+	{0xFF0C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '，', ',', '，', '，', '，'},                             // 0xFF0C ，
+	{0xFF0D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '－', '-', '－', '－', '－'},                             // 0xFF0D －
+	{0xFF0E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '．', '.', '．', '．', '．'},                             // 0xFF0E ．
+	{0xFF0F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '／', '/', '／', '／', '／'},                             // 0xFF0F ／
+	{0xFF10, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '０', '0', '０', '０', '０'},                              // 0xFF10 ０
+	{0xFF11, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '１', '1', '１', '１', '１'},                              // 0xFF11 １
+	{0xFF12, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '２', '2', '２', '２', '２'},                              // 0xFF12 ２
+	{0xFF13, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '３', '3', '３', '３', '３'},                              // 0xFF13 ３
+	{0xFF14, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '４', '4', '４', '４', '４'},                              // 0xFF14 ４
+	{0xFF15, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '５', '5', '５', '５', '５'},                              // 0xFF15 ５
+	{0xFF16, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '６', '6', '６', '６', '６'},                              // 0xFF16 ６
+	{0xFF17, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '７', '7', '７', '７', '７'},                              // 0xFF17 ７
+	{0xFF18, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '８', '8', '８', '８', '８'},                              // 0xFF18 ８
+	{0xFF19, ctLatinDigit, ccUndefined, cwWide, vcUndefined, '９', '9', '９', '９', '９'},                              // 0xFF19 ９
+	{0xFF1A, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '：', ':', '：', '：', '：'},                             // 0xFF1A ：
+	{0xFF1B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '；', ';', '；', '；', '；'},                             // 0xFF1B ；
+	{0xFF1C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＜', '<', '＜', '＜', '＜'},                             // 0xFF1C ＜
+	{0xFF1D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＝', '=', '＝', '＝', '＝'},                             // 0xFF1D ＝
+	{0xFF1E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＞', '>', '＞', '＞', '＞'},                             // 0xFF1E ＞
+	{0xFF1F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '？', '?', '？', '？', '？'},                             // 0xFF1F ？
+	{0xFF20, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＠', '@', '＠', '＠', '＠'},                             // 0xFF20 ＠
+	{0xFF21, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ａ', 'A', 'Ａ', 'Ａ', 'Ａ'},                                 // 0xFF21 Ａ
+	{0xFF22, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｂ', 'B', 'Ｂ', 'Ｂ', 'Ｂ'},                                 // 0xFF22 Ｂ
+	{0xFF23, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｃ', 'C', 'Ｃ', 'Ｃ', 'Ｃ'},                                 // 0xFF23 Ｃ
+	{0xFF24, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｄ', 'D', 'Ｄ', 'Ｄ', 'Ｄ'},                                 // 0xFF24 Ｄ
+	{0xFF25, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｅ', 'E', 'Ｅ', 'Ｅ', 'Ｅ'},                                 // 0xFF25 Ｅ
+	{0xFF26, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｆ', 'F', 'Ｆ', 'Ｆ', 'Ｆ'},                                 // 0xFF26 Ｆ
+	{0xFF27, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｇ', 'G', 'Ｇ', 'Ｇ', 'Ｇ'},                                 // 0xFF27 Ｇ
+	{0xFF28, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｈ', 'H', 'Ｈ', 'Ｈ', 'Ｈ'},                                 // 0xFF28 Ｈ
+	{0xFF29, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｉ', 'I', 'Ｉ', 'Ｉ', 'Ｉ'},                                 // 0xFF29 Ｉ
+	{0xFF2A, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｊ', 'J', 'Ｊ', 'Ｊ', 'Ｊ'},                                 // 0xFF2A Ｊ
+	{0xFF2B, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｋ', 'K', 'Ｋ', 'Ｋ', 'Ｋ'},                                 // 0xFF2B Ｋ
+	{0xFF2C, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｌ', 'L', 'Ｌ', 'Ｌ', 'Ｌ'},                                 // 0xFF2C Ｌ
+	{0xFF2D, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｍ', 'M', 'Ｍ', 'Ｍ', 'Ｍ'},                                 // 0xFF2D Ｍ
+	{0xFF2E, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｎ', 'N', 'Ｎ', 'Ｎ', 'Ｎ'},                                 // 0xFF2E Ｎ
+	{0xFF2F, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｏ', 'O', 'Ｏ', 'Ｏ', 'Ｏ'},                                 // 0xFF2F Ｏ
+	{0xFF30, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｐ', 'P', 'Ｐ', 'Ｐ', 'Ｐ'},                                 // 0xFF30 Ｐ
+	{0xFF31, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｑ', 'Q', 'Ｑ', 'Ｑ', 'Ｑ'},                                 // 0xFF31 Ｑ
+	{0xFF32, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｒ', 'R', 'Ｒ', 'Ｒ', 'Ｒ'},                                 // 0xFF32 Ｒ
+	{0xFF33, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｓ', 'S', 'Ｓ', 'Ｓ', 'Ｓ'},                                 // 0xFF33 Ｓ
+	{0xFF34, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｔ', 'T', 'Ｔ', 'Ｔ', 'Ｔ'},                                 // 0xFF34 Ｔ
+	{0xFF35, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｕ', 'U', 'Ｕ', 'Ｕ', 'Ｕ'},                                 // 0xFF35 Ｕ
+	{0xFF36, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｖ', 'V', 'Ｖ', 'Ｖ', 'Ｖ'},                                 // 0xFF36 Ｖ
+	{0xFF37, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｗ', 'W', 'Ｗ', 'Ｗ', 'Ｗ'},                                 // 0xFF37 Ｗ
+	{0xFF38, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｘ', 'X', 'Ｘ', 'Ｘ', 'Ｘ'},                                 // 0xFF38 Ｘ
+	{0xFF39, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｙ', 'Y', 'Ｙ', 'Ｙ', 'Ｙ'},                                 // 0xFF39 Ｙ
+	{0xFF3A, ctLatinLetter, ccUpper, cwWide, vcUndefined, 'ｚ', 'Z', 'Ｚ', 'Ｚ', 'Ｚ'},                                 // 0xFF3A Ｚ
+	{0xFF3B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '［', '[', '［', '［', '［'},                             // 0xFF3B ［
+	{0xFF3C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＼', '\\', '＼', '＼', '＼'},                            // 0xFF3C ＼
+	{0xFF3D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '］', ']', '］', '］', '］'},                             // 0xFF3D ］
+	{0xFF3E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＾', '^', '＾', '＾', '＾'},                             // 0xFF3E ＾
+	{0xFF3F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '＿', '_', '＿', '＿', '＿'},                             // 0xFF3F ＿
+	{0xFF40, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｀', '`', '｀', '｀', '｀'},                             // 0xFF40 ｀
+	{0xFF41, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ａ', 'a', 'ａ', 'ａ', 'ａ'},                                 // 0xFF41 ａ
+	{0xFF42, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｂ', 'b', 'ｂ', 'ｂ', 'ｂ'},                                 // 0xFF42 ｂ
+	{0xFF43, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｃ', 'c', 'ｃ', 'ｃ', 'ｃ'},                                 // 0xFF43 ｃ
+	{0xFF44, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｄ', 'd', 'ｄ', 'ｄ', 'ｄ'},                                 // 0xFF44 ｄ
+	{0xFF45, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｅ', 'e', 'ｅ', 'ｅ', 'ｅ'},                                 // 0xFF45 ｅ
+	{0xFF46, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｆ', 'f', 'ｆ', 'ｆ', 'ｆ'},                                 // 0xFF46 ｆ
+	{0xFF47, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｇ', 'g', 'ｇ', 'ｇ', 'ｇ'},                                 // 0xFF47 ｇ
+	{0xFF48, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｈ', 'h', 'ｈ', 'ｈ', 'ｈ'},                                 // 0xFF48 ｈ
+	{0xFF49, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｉ', 'i', 'ｉ', 'ｉ', 'ｉ'},                                 // 0xFF49 ｉ
+	{0xFF4A, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｊ', 'j', 'ｊ', 'ｊ', 'ｊ'},                                 // 0xFF4A ｊ
+	{0xFF4B, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｋ', 'k', 'ｋ', 'ｋ', 'ｋ'},                                 // 0xFF4B ｋ
+	{0xFF4C, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｌ', 'l', 'ｌ', 'ｌ', 'ｌ'},                                 // 0xFF4C ｌ
+	{0xFF4D, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｍ', 'm', 'ｍ', 'ｍ', 'ｍ'},                                 // 0xFF4D ｍ
+	{0xFF4E, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｎ', 'n', 'ｎ', 'ｎ', 'ｎ'},                                 // 0xFF4E ｎ
+	{0xFF4F, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｏ', 'o', 'ｏ', 'ｏ', 'ｏ'},                                 // 0xFF4F ｏ
+	{0xFF50, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｐ', 'p', 'ｐ', 'ｐ', 'ｐ'},                                 // 0xFF50 ｐ
+	{0xFF51, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｑ', 'q', 'ｑ', 'ｑ', 'ｑ'},                                 // 0xFF51 ｑ
+	{0xFF52, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｒ', 'r', 'ｒ', 'ｒ', 'ｒ'},                                 // 0xFF52 ｒ
+	{0xFF53, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｓ', 's', 'ｓ', 'ｓ', 'ｓ'},                                 // 0xFF53 ｓ
+	{0xFF54, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｔ', 't', 'ｔ', 'ｔ', 'ｔ'},                                 // 0xFF54 ｔ
+	{0xFF55, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｕ', 'u', 'ｕ', 'ｕ', 'ｕ'},                                 // 0xFF55 ｕ
+	{0xFF56, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｖ', 'v', 'ｖ', 'ｖ', 'ｖ'},                                 // 0xFF56 ｖ
+	{0xFF57, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｗ', 'w', 'ｗ', 'ｗ', 'ｗ'},                                 // 0xFF57 ｗ
+	{0xFF58, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｘ', 'x', 'ｘ', 'ｘ', 'ｘ'},                                 // 0xFF58 ｘ
+	{0xFF59, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｙ', 'y', 'ｙ', 'ｙ', 'ｙ'},                                 // 0xFF59 ｙ
+	{0xFF5A, ctLatinLetter, ccLower, cwWide, vcUndefined, 'Ｚ', 'z', 'ｚ', 'ｚ', 'ｚ'},                                 // 0xFF5A ｚ
+	{0xFF5B, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｛', '{', '｛', '｛', '｛'},                             // 0xFF5B ｛
+	{0xFF5C, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｜', '|', '｜', '｜', '｜'},                             // 0xFF5C ｜
+	{0xFF5D, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｝', '}', '｝', '｝', '｝'},                             // 0xFF5D ｝
+	{0xFF5E, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '～', '~', '～', '～', '～'},                             // 0xFF5E ～
+	{0xFF5F, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｟', '｟', '｟', '｟', '｟'},                             // 0xFF5F ｟
+	{0xFF60, ctLatinSymbol, ccUndefined, cwWide, vcUndefined, '｠', '｠', '｠', '｠', '｠'},                             // 0xFF60 ｠
+	{0xFF61, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '｡', '。', '｡', '｡', '｡'},                            // 0xFF61 ｡
+	{0xFF62, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '｢', '「', '｢', '｢', '｢'},                            // 0xFF62 ｢
+	{0xFF63, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '｣', '」', '｣', '｣', '｣'},                            // 0xFF63 ｣
+	{0xFF64, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '､', '、', '､', '､', '､'},                            // 0xFF64 ､
+	{0xFF65, ctKanaSymbol, ccUndefined, cwNarrow, vcUndefined, '･', '・', '･', '･', '･'},                            // 0xFF65 ･
+	{0xFF66, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'を', 'ヲ', 'ｦ', 'ｦ', 'ｦ'},                             // 0xFF66 ｦ
+	{0xFF67, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぁ', 'ァ', 'ｧ', 'ｧ', 'ｱ'},                             // 0xFF67 ｧ
+	{0xFF68, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぃ', 'ィ', 'ｨ', 'ｨ', 'ｲ'},                             // 0xFF68 ｨ
+	{0xFF69, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぅ', 'ゥ', 'ｩ', 'ｩ', 'ｳ'},                             // 0xFF69 ｩ
+	{0xFF6A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぇ', 'ェ', 'ｪ', 'ｪ', 'ｴ'},                             // 0xFF6A ｪ
+	{0xFF6B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぉ', 'ォ', 'ｫ', 'ｫ', 'ｵ'},                             // 0xFF6B ｫ
+	{0xFF6C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ゃ', 'ャ', 'ｬ', 'ｬ', 'ﾔ'},                             // 0xFF6C ｬ
+	{0xFF6D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ゅ', 'ュ', 'ｭ', 'ｭ', 'ﾕ'},                             // 0xFF6D ｭ
+	{0xFF6E, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ょ', 'ョ', 'ｮ', 'ｮ', 'ﾖ'},                             // 0xFF6E ｮ
+	{0xFF6F, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'っ', 'ッ', 'ｯ', 'ｯ', 'ﾂ'},                             // 0xFF6F ｯ
+	{0xFF70, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ｰ', 'ー', 'ｰ', 'ｰ', 'ｰ'},                             // 0xFF70 ｰ
+	{0xFF71, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'あ', 'ア', 'ｱ', 'ｱ', 'ｱ'},                             // 0xFF71 ｱ
+	{0xFF72, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'い', 'イ', 'ｲ', 'ｲ', 'ｲ'},                             // 0xFF72 ｲ
+	{0xFF73, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'う', 'ウ', 'ｳ', 'ｳ', 'ｳ'},                             // 0xFF73 ｳ
+	{0xFF74, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'え', 'エ', 'ｴ', 'ｴ', 'ｴ'},                             // 0xFF74 ｴ
+	{0xFF75, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'お', 'オ', 'ｵ', 'ｵ', 'ｵ'},                             // 0xFF75 ｵ
+	{0xFF76, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'か', 'カ', 'ｶ', 'ｶ', 'ｶ'},                             // 0xFF76 ｶ
+	{0xFF77, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'き', 'キ', 'ｷ', 'ｷ', 'ｷ'},                             // 0xFF77 ｷ
+	{0xFF78, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'く', 'ク', 'ｸ', 'ｸ', 'ｸ'},                             // 0xFF78 ｸ
+	{0xFF79, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'け', 'ケ', 'ｹ', 'ｹ', 'ｹ'},                             // 0xFF79 ｹ
+	{0xFF7A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'こ', 'コ', 'ｺ', 'ｺ', 'ｺ'},                             // 0xFF7A ｺ
+	{0xFF7B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'さ', 'サ', 'ｻ', 'ｻ', 'ｻ'},                             // 0xFF7B ｻ
+	{0xFF7C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'し', 'シ', 'ｼ', 'ｼ', 'ｼ'},                             // 0xFF7C ｼ
+	{0xFF7D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'す', 'ス', 'ｽ', 'ｽ', 'ｽ'},                             // 0xFF7D ｽ
+	{0xFF7E, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'せ', 'セ', 'ｾ', 'ｾ', 'ｾ'},                             // 0xFF7E ｾ
+	{0xFF7F, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'そ', 'ソ', 'ｿ', 'ｿ', 'ｿ'},                             // 0xFF7F ｿ
+	{0xFF80, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'た', 'タ', 'ﾀ', 'ﾀ', 'ﾀ'},                             // 0xFF80 ﾀ
+	{0xFF81, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ち', 'チ', 'ﾁ', 'ﾁ', 'ﾁ'},                             // 0xFF81 ﾁ
+	{0xFF82, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'つ', 'ツ', 'ﾂ', 'ﾂ', 'ﾂ'},                             // 0xFF82 ﾂ
+	{0xFF83, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'て', 'テ', 'ﾃ', 'ﾃ', 'ﾃ'},                             // 0xFF83 ﾃ
+	{0xFF84, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'と', 'ト', 'ﾄ', 'ﾄ', 'ﾄ'},                             // 0xFF84 ﾄ
+	{0xFF85, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'な', 'ナ', 'ﾅ', 'ﾅ', 'ﾅ'},                             // 0xFF85 ﾅ
+	{0xFF86, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'に', 'ニ', 'ﾆ', 'ﾆ', 'ﾆ'},                             // 0xFF86 ﾆ
+	{0xFF87, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ぬ', 'ヌ', 'ﾇ', 'ﾇ', 'ﾇ'},                             // 0xFF87 ﾇ
+	{0xFF88, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ね', 'ネ', 'ﾈ', 'ﾈ', 'ﾈ'},                             // 0xFF88 ﾈ
+	{0xFF89, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'の', 'ノ', 'ﾉ', 'ﾉ', 'ﾉ'},                             // 0xFF89 ﾉ
+	{0xFF8A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'は', 'ハ', 'ﾊ', 'ﾊ', 'ﾊ'},                             // 0xFF8A ﾊ
+	{0xFF8B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ひ', 'ヒ', 'ﾋ', 'ﾋ', 'ﾋ'},                             // 0xFF8B ﾋ
+	{0xFF8C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ふ', 'フ', 'ﾌ', 'ﾌ', 'ﾌ'},                             // 0xFF8C ﾌ
+	{0xFF8D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'へ', 'ヘ', 'ﾍ', 'ﾍ', 'ﾍ'},                             // 0xFF8D ﾍ
+	{0xFF8E, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ほ', 'ホ', 'ﾎ', 'ﾎ', 'ﾎ'},                             // 0xFF8E ﾎ
+	{0xFF8F, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ま', 'マ', 'ﾏ', 'ﾏ', 'ﾏ'},                             // 0xFF8F ﾏ
+	{0xFF90, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'み', 'ミ', 'ﾐ', 'ﾐ', 'ﾐ'},                             // 0xFF90 ﾐ
+	{0xFF91, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'む', 'ム', 'ﾑ', 'ﾑ', 'ﾑ'},                             // 0xFF91 ﾑ
+	{0xFF92, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'め', 'メ', 'ﾒ', 'ﾒ', 'ﾒ'},                             // 0xFF92 ﾒ
+	{0xFF93, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'も', 'モ', 'ﾓ', 'ﾓ', 'ﾓ'},                             // 0xFF93 ﾓ
+	{0xFF94, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'や', 'ヤ', 'ﾔ', 'ﾔ', 'ﾔ'},                             // 0xFF94 ﾔ
+	{0xFF95, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ゆ', 'ユ', 'ﾕ', 'ﾕ', 'ﾕ'},                             // 0xFF95 ﾕ
+	{0xFF96, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'よ', 'ヨ', 'ﾖ', 'ﾖ', 'ﾖ'},                             // 0xFF96 ﾖ
+	{0xFF97, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ら', 'ラ', 'ﾗ', 'ﾗ', 'ﾗ'},                             // 0xFF97 ﾗ
+	{0xFF98, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'り', 'リ', 'ﾘ', 'ﾘ', 'ﾘ'},                             // 0xFF98 ﾘ
+	{0xFF99, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'る', 'ル', 'ﾙ', 'ﾙ', 'ﾙ'},                             // 0xFF99 ﾙ
+	{0xFF9A, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'れ', 'レ', 'ﾚ', 'ﾚ', 'ﾚ'},                             // 0xFF9A ﾚ
+	{0xFF9B, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ろ', 'ロ', 'ﾛ', 'ﾛ', 'ﾛ'},                             // 0xFF9B ﾛ
+	{0xFF9C, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'わ', 'ワ', 'ﾜ', 'ﾜ', 'ﾜ'},                             // 0xFF9C ﾜ
+	{0xFF9D, ctKanaLetter, ccKatakana, cwNarrow, vcUndefined, 'ん', 'ン', 'ﾝ', 'ﾝ', 'ﾝ'},                             // 0xFF9D ﾝ
+	{0xFF9E, ctKanaVom, ccLegacy, cwNarrow, vcUndefined, '゙', '゛', 'ﾞ', 'ﾞ', 'ﾞ'},                                  // 0xFF9E ﾞ
+	{0xFF9F, ctKanaVom, ccLegacy, cwNarrow, vcUndefined, '゚', '゜', 'ﾟ', 'ﾟ', 'ﾟ'},                                  // 0xFF9F ﾟ
+}
+
+// The compatibility decompositions of the enclosed and compatibility characters
+var decompTable = map[rune]string{
+	0x2100: "a/c",     // ℀
+	0x2101: "a/s",     // ℁
+	0x2102: "C",       // ℂ
+	0x2103: "°C",      // ℃
+	0x2105: "c/o",     // ℅
+	0x2106: "c/u",     // ℆
+	0x2107: "Ɛ",       // ℇ
+	0x2109: "°F",      // ℉
+	0x210A: "g",       // ℊ
+	0x210B: "H",       // ℋ
+	0x210C: "H",       // ℌ
+	0x210D: "H",       // ℍ
+	0x210E: "h",       // ℎ
+	0x210F: "ħ",       // ℏ
+	0x2110: "I",       // ℐ
+	0x2111: "I",       // ℑ
+	0x2112: "L",       // ℒ
+	0x2113: "l",       // ℓ
+	0x2115: "N",       // ℕ
+	0x2116: "No",      // №
+	0x2119: "P",       // ℙ
+	0x211A: "Q",       // ℚ
+	0x211B: "R",       // ℛ
+	0x211C: "R",       // ℜ
+	0x211D: "R",       // ℝ
+	0x2120: "SM",      // ℠
+	0x2121: "TEL",     // ℡
+	0x2122: "TM",      // ™
+	0x2124: "Z",       // ℤ
+	0x2128: "Z",       // ℨ
+	0x212C: "B",       // ℬ
+	0x212D: "C",       // ℭ
+	0x212F: "e",       // ℯ
+	0x2130: "E",       // ℰ
+	0x2131: "F",       // ℱ
+	0x2133: "M",       // ℳ
+	0x2134: "o",       // ℴ
+	0x2135: "א",       // ℵ
+	0x2136: "ב",       // ℶ
+	0x2137: "ג",       // ℷ
+	0x2138: "ד",       // ℸ
+	0x2139: "i",       // ℹ
+	0x213B: "FAX",     // ℻
+	0x213C: "π",       // ℼ
+	0x213D: "γ",       // ℽ
+	0x213E: "Γ",       // ℾ
+	0x213F: "Π",       // ℿ
+	0x2140: "∑",       // ⅀
+	0x2145: "D",       // ⅅ
+	0x2146: "d",       // ⅆ
+	0x2147: "e",       // ⅇ
+	0x2148: "i",       // ⅈ
+	0x2149: "j",       // ⅉ
+	0x2460: "1",       // ①
+	0x2461: "2",       // ②
+	0x2462: "3",       // ③
+	0x2463: "4",       // ④
+	0x2464: "5",       // ⑤
+	0x2465: "6",       // ⑥
+	0x2466: "7",       // ⑦
+	0x2467: "8",       // ⑧
+	0x2468: "9",       // ⑨
+	0x2469: "10",      // ⑩
+	0x246A: "11",      // ⑪
+	0x246B: "12",      // ⑫
+	0x246C: "13",      // ⑬
+	0x246D: "14",      // ⑭
+	0x246E: "15",      // ⑮
+	0x246F: "16",      // ⑯
+	0x2470: "17",      // ⑰
+	0x2471: "18",      // ⑱
+	0x2472: "19",      // ⑲
+	0x2473: "20",      // ⑳
+	0x2474: "(1)",     // ⑴
+	0x2475: "(2)",     // ⑵
+	0x2476: "(3)",     // ⑶
+	0x2477: "(4)",     // ⑷
+	0x2478: "(5)",     // ⑸
+	0x2479: "(6)",     // ⑹
+	0x247A: "(7)",     // ⑺
+	0x247B: "(8)",     // ⑻
+	0x247C: "(9)",     // ⑼
+	0x247D: "(10)",    // ⑽
+	0x247E: "(11)",    // ⑾
+	0x247F: "(12)",    // ⑿
+	0x2480: "(13)",    // ⒀
+	0x2481: "(14)",    // ⒁
+	0x2482: "(15)",    // ⒂
+	0x2483: "(16)",    // ⒃
+	0x2484: "(17)",    // ⒄
+	0x2485: "(18)",    // ⒅
+	0x2486: "(19)",    // ⒆
+	0x2487: "(20)",    // ⒇
+	0x2488: "1.",      // ⒈
+	0x2489: "2.",      // ⒉
+	0x248A: "3.",      // ⒊
+	0x248B: "4.",      // ⒋
+	0x248C: "5.",      // ⒌
+	0x248D: "6.",      // ⒍
+	0x248E: "7.",      // ⒎
+	0x248F: "8.",      // ⒏
+	0x2490: "9.",      // ⒐
+	0x2491: "10.",     // ⒑
+	0x2492: "11.",     // ⒒
+	0x2493: "12.",     // ⒓
+	0x2494: "13.",     // ⒔
+	0x2495: "14.",     // ⒕
+	0x2496: "15.",     // ⒖
+	0x2497: "16.",     // ⒗
+	0x2498: "17.",     // ⒘
+	0x2499: "18.",     // ⒙
+	0x249A: "19.",     // ⒚
+	0x249B: "20.",     // ⒛
+	0x249C: "(a)",     // ⒜
+	0x249D: "(b)",     // ⒝
+	0x249E: "(c)",     // ⒞
+	0x249F: "(d)",     // ⒟
+	0x24A0: "(e)",     // ⒠
+	0x24A1: "(f)",     // ⒡
+	0x24A2: "(g)",     // ⒢
+	0x24A3: "(h)",     // ⒣
+	0x24A4: "(i)",     // ⒤
+	0x24A5: "(j)",     // ⒥
+	0x24A6: "(k)",     // ⒦
+	0x24A7: "(l)",     // ⒧
+	0x24A8: "(m)",     // ⒨
+	0x24A9: "(n)",     // ⒩
+	0x24AA: "(o)",     // ⒪
+	0x24AB: "(p)",     // ⒫
+	0x24AC: "(q)",     // ⒬
+	0x24AD: "(r)",     // ⒭
+	0x24AE: "(s)",     // ⒮
+	0x24AF: "(t)",     // ⒯
+	0x24B0: "(u)",     // ⒰
+	0x24B1: "(v)",     // ⒱
+	0x24B2: "(w)",     // ⒲
+	0x24B3: "(x)",     // ⒳
+	0x24B4: "(y)",     // ⒴
+	0x24B5: "(z)",     // ⒵
+	0x24B6: "A",       // Ⓐ
+	0x24B7: "B",       // Ⓑ
+	0x24B8: "C",       // Ⓒ
+	0x24B9: "D",       // Ⓓ
+	0x24BA: "E",       // Ⓔ
+	0x24BB: "F",       // Ⓕ
+	0x24BC: "G",       // Ⓖ
+	0x24BD: "H",       // Ⓗ
+	0x24BE: "I",       // Ⓘ
+	0x24BF: "J",       // Ⓙ
+	0x24C0: "K",       // Ⓚ
+	0x24C1: "L",       // Ⓛ
+	0x24C2: "M",       // Ⓜ
+	0x24C3: "N",       // Ⓝ
+	0x24C4: "O",       // Ⓞ
+	0x24C5: "P",       // Ⓟ
+	0x24C6: "Q",       // Ⓠ
+	0x24C7: "R",       // Ⓡ
+	0x24C8: "S",       // Ⓢ
+	0x24C9: "T",       // Ⓣ
+	0x24CA: "U",       // Ⓤ
+	0x24CB: "V",       // Ⓥ
+	0x24CC: "W",       // Ⓦ
+	0x24CD: "X",       // Ⓧ
+	0x24CE: "Y",       // Ⓨ
+	0x24CF: "Z",       // Ⓩ
+	0x24D0: "a",       // ⓐ
+	0x24D1: "b",       // ⓑ
+	0x24D2: "c",       // ⓒ
+	0x24D3: "d",       // ⓓ
+	0x24D4: "e",       // ⓔ
+	0x24D5: "f",       // ⓕ
+	0x24D6: "g",       // ⓖ
+	0x24D7: "h",       // ⓗ
+	0x24D8: "i",       // ⓘ
+	0x24D9: "j",       // ⓙ
+	0x24DA: "k",       // ⓚ
+	0x24DB: "l",       // ⓛ
+	0x24DC: "m",       // ⓜ
+	0x24DD: "n",       // ⓝ
+	0x24DE: "o",       // ⓞ
+	0x24DF: "p",       // ⓟ
+	0x24E0: "q",       // ⓠ
+	0x24E1: "r",       // ⓡ
+	0x24E2: "s",       // ⓢ
+	0x24E3: "t",       // ⓣ
+	0x24E4: "u",       // ⓤ
+	0x24E5: "v",       // ⓥ
+	0x24E6: "w",       // ⓦ
+	0x24E7: "x",       // ⓧ
+	0x24E8: "y",       // ⓨ
+	0x24E9: "z",       // ⓩ
+	0x24EA: "0",       // ⓪
+	0x3200: "(ᄀ)",     // ㈀
+	0x3201: "(ᄂ)",     // ㈁
+	0x3202: "(ᄃ)",     // ㈂
+	0x3203: "(ᄅ)",     // ㈃
+	0x3204: "(ᄆ)",     // ㈄
+	0x3205: "(ᄇ)",     // ㈅
+	0x3206: "(ᄉ)",     // ㈆
+	0x3207: "(ᄋ)",     // ㈇
+	0x3208: "(ᄌ)",     // ㈈
+	0x3209: "(ᄎ)",     // ㈉
+	0x320A: "(ᄏ)",     // ㈊
+	0x320B: "(ᄐ)",     // ㈋
+	0x320C: "(ᄑ)",     // ㈌
+	0x320D: "(ᄒ)",     // ㈍
+	0x320E: "(가)",    // ㈎
+	0x320F: "(나)",    // ㈏
+	0x3210: "(다)",    // ㈐
+	0x3211: "(라)",    // ㈑
+	0x3212: "(마)",    // ㈒
+	0x3213: "(바)",    // ㈓
+	0x3214: "(사)",    // ㈔
+	0x3215: "(아)",    // ㈕
+	0x3216: "(자)",    // ㈖
+	0x3217: "(차)",    // ㈗
+	0x3218: "(카)",    // ㈘
+	0x3219: "(타)",    // ㈙
+	0x321A: "(파)",    // ㈚
+	0x321B: "(하)",    // ㈛
+	0x321C: "(주)",    // ㈜
+	0x321D: "(오전)", // ㈝
+	0x321E: "(오후)",  // ㈞
+	0x3220: "(一)",     // ㈠
+	0x3221: "(二)",     // ㈡
+	0x3222: "(三)",     // ㈢
+	0x3223: "(四)",     // ㈣
+	0x3224: "(五)",     // ㈤
+	0x3225: "(六)",     // ㈥
+	0x3226: "(七)",     // ㈦
+	0x3227: "(八)",     // ㈧
+	0x3228: "(九)",     // ㈨
+	0x3229: "(十)",     // ㈩
+	0x322A: "(月)",     // ㈪
+	0x322B: "(火)",     // ㈫
+	0x322C: "(水)",     // ㈬
+	0x322D: "(木)",     // ㈭
+	0x322E: "(金)",     // ㈮
+	0x322F: "(土)",     // ㈯
+	0x3230: "(日)",     // ㈰
+	0x3231: "(株)",     // ㈱
+	0x3232: "(有)",     // ㈲
+	0x3233: "(社)",     // ㈳
+	0x3234: "(名)",     // ㈴
+	0x3235: "(特)",     // ㈵
+	0x3236: "(財)",     // ㈶
+	0x3237: "(祝)",     // ㈷
+	0x3238: "(労)",     // ㈸
+	0x3239: "(代)",     // ㈹
+	0x323A: "(呼)",     // ㈺
+	0x323B: "(学)",     // ㈻
+	0x323C: "(監)",     // ㈼
+	0x323D: "(企)",     // ㈽
+	0x323E: "(資)",     // ㈾
+	0x323F: "(協)",     // ㈿
+	0x3240: "(祭)",     // ㉀
+	0x3241: "(休)",     // ㉁
+	0x3242: "(自)",     // ㉂
+	0x3243: "(至)",     // ㉃
+	0x3244: "問",       // ㉄
+	0x3245: "幼",       // ㉅
+	0x3246: "文",       // ㉆
+	0x3247: "箏",       // ㉇
+	0x3250: "PTE",     // ㉐
+	0x3251: "21",      // ㉑
+	0x3252: "22",      // ㉒
+	0x3253: "23",      // ㉓
+	0x3254: "24",      // ㉔
+	0x3255: "25",      // ㉕
+	0x3256: "26",      // ㉖
+	0x3257: "27",      // ㉗
+	0x3258: "28",      // ㉘
+	0x3259: "29",      // ㉙
+	0x325A: "30",      // ㉚
+	0x325B: "31",      // ㉛
+	0x325C: "32",      // ㉜
+	0x325D: "33",      // ㉝
+	0x325E: "34",      // ㉞
+	0x325F: "35",      // ㉟
+	0x3260: "ᄀ",       // ㉠
+	0x3261: "ᄂ",       // ㉡
+	0x3262: "ᄃ",       // ㉢
+	0x3263: "ᄅ",       // ㉣
+	0x3264: "ᄆ",       // ㉤
+	0x3265: "ᄇ",       // ㉥
+	0x3266: "ᄉ",       // ㉦
+	0x3267: "ᄋ",       // ㉧
+	0x3268: "ᄌ",       // ㉨
+	0x3269: "ᄎ",       // ㉩
+	0x326A: "ᄏ",       // ㉪
+	0x326B: "ᄐ",       // ㉫
+	0x326C: "ᄑ",       // ㉬
+	0x326D: "ᄒ",       // ㉭
+	0x326E: "가",      // ㉮
+	0x326F: "나",      // ㉯
+	0x3270: "다",      // ㉰
+	0x3271: "라",      // ㉱
+	0x3272: "마",      // ㉲
+	0x3273: "바",      // ㉳
+	0x3274: "사",      // ㉴
+	0x3275: "아",      // ㉵
+	0x3276: "자",      // ㉶
+	0x3277: "차",      // ㉷
+	0x3278: "카",      // ㉸
+	0x3279: "타",      // ㉹
+	0x327A: "파",      // ㉺
+	0x327B: "하",      // ㉻
+	0x327C: "참고",   // ㉼
+	0x327D: "주의",    // ㉽
+	0x327E: "우",      // ㉾
+	0x3280: "一",       // ㊀
+	0x3281: "二",       // ㊁
+	0x3282: "三",       // ㊂
+	0x3283: "四",       // ㊃
+	0x3284: "五",       // ㊄
+	0x3285: "六",       // ㊅
+	0x3286: "七",       // ㊆
+	0x3287: "八",       // ㊇
+	0x3288: "九",       // ㊈
+	0x3289: "十",       // ㊉
+	0x328A: "月",       // ㊊
+	0x328B: "火",       // ㊋
+	0x328C: "水",       // ㊌
+	0x328D: "木",       // ㊍
+	0x328E: "金",       // ㊎
+	0x328F: "土",       // ㊏
+	0x3290: "日",       // ㊐
+	0x3291: "株",       // ㊑
+	0x3292: "有",       // ㊒
+	0x3293: "社",       // ㊓
+	0x3294: "名",       // ㊔
+	0x3295: "特",       // ㊕
+	0x3296: "財",       // ㊖
+	0x3297: "祝",       // ㊗
+	0x3298: "労",       // ㊘
+	0x3299: "秘",       // ㊙
+	0x329A: "男",       // ㊚
+	0x329B: "女",       // ㊛
+	0x329C: "適",       // ㊜
+	0x329D: "優",       // ㊝
+	0x329E: "印",       // ㊞
+	0x329F: "注",       // ㊟
+	0x32A0: "項",       // ㊠
+	0x32A1: "休",       // ㊡
+	0x32A2: "写",       // ㊢
+	0x32A3: "正",       // ㊣
+	0x32A4: "上",       // ㊤
+	0x32A5: "中",       // ㊥
+	0x32A6: "下",       // ㊦
+	0x32A7: "左",       // ㊧
+	0x32A8: "右",       // ㊨
+	0x32A9: "医",       // ㊩
+	0x32AA: "宗",       // ㊪
+	0x32AB: "学",       // ㊫
+	0x32AC: "監",       // ㊬
+	0x32AD: "企",       // ㊭
+	0x32AE: "資",       // ㊮
+	0x32AF: "協",       // ㊯
+	0x32B0: "夜",       // ㊰
+	0x32B1: "36",      // ㊱
+	0x32B2: "37",      // ㊲
+	0x32B3: "38",      // ㊳
+	0x32B4: "39",      // ㊴
+	0x32B5: "40",      // ㊵
+	0x32B6: "41",      // ㊶
+	0x32B7: "42",      // ㊷
+	0x32B8: "43",      // ㊸
+	0x32B9: "44",      // ㊹
+	0x32BA: "45",      // ㊺
+	0x32BB: "46",      // ㊻
+	0x32BC: "47",      // ㊼
+	0x32BD: "48",      // ㊽
+	0x32BE: "49",      // ㊾
+	0x32BF: "50",      // ㊿
+	0x32C0: "1月",      // ㋀
+	0x32C1: "2月",      // ㋁
+	0x32C2: "3月",      // ㋂
+	0x32C3: "4月",      // ㋃
+	0x32C4: "5月",      // ㋄
+	0x32C5: "6月",      // ㋅
+	0x32C6: "7月",      // ㋆
+	0x32C7: "8月",      // ㋇
+	0x32C8: "9月",      // ㋈
+	0x32C9: "10月",     // ㋉
+	0x32CA: "11月",     // ㋊
+	0x32CB: "12月",     // ㋋
+	0x32CC: "Hg",      // ㋌
+	0x32CD: "erg",     // ㋍
+	0x32CE: "eV",      // ㋎
+	0x32CF: "LTD",     // ㋏
+	0x32D0: "ア",       // ㋐
+	0x32D1: "イ",       // ㋑
+	0x32D2: "ウ",       // ㋒
+	0x32D3: "エ",       // ㋓
+	0x32D4: "オ",       // ㋔
+	0x32D5: "カ",       // ㋕
+	0x32D6: "キ",       // ㋖
+	0x32D7: "ク",       // ㋗
+	0x32D8: "ケ",       // ㋘
+	0x32D9: "コ",       // ㋙
+	0x32DA: "サ",       // ㋚
+	0x32DB: "シ",       // ㋛
+	0x32DC: "ス",       // ㋜
+	0x32DD: "セ",       // ㋝
+	0x32DE: "ソ",       // ㋞
+	0x32DF: "タ",       // ㋟
+	0x32E0: "チ",       // ㋠
+	0x32E1: "ツ",       // ㋡
+	0x32E2: "テ",       // ㋢
+	0x32E3: "ト",       // ㋣
+	0x32E4: "ナ",       // ㋤
+	0x32E5: "ニ",       // ㋥
+	0x32E6: "ヌ",       // ㋦
+	0x32E7: "ネ",       // ㋧
+	0x32E8: "ノ",       // ㋨
+	0x32E9: "ハ",       // ㋩
+	0x32EA: "ヒ",       // ㋪
+	0x32EB: "フ",       // ㋫
+	0x32EC: "ヘ",       // ㋬
+	0x32ED: "ホ",       // ㋭
+	0x32EE: "マ",       // ㋮
+	0x32EF: "ミ",       // ㋯
+	0x32F0: "ム",       // ㋰
+	0x32F1: "メ",       // ㋱
+	0x32F2: "モ",       // ㋲
+	0x32F3: "ヤ",       // ㋳
+	0x32F4: "ユ",       // ㋴
+	0x32F5: "ヨ",       // ㋵
+	0x32F6: "ラ",       // ㋶
+	0x32F7: "リ",       // ㋷
+	0x32F8: "ル",       // ㋸
+	0x32F9: "レ",       // ㋹
+	0x32FA: "ロ",       // ㋺
+	0x32FB: "ワ",       // ㋻
+	0x32FC: "ヰ",       // ㋼
+	0x32FD: "ヱ",       // ㋽
+	0x32FE: "ヲ",       // ㋾
+	0x32FF: "令和",      // ㋿
+	0x3300: "アパート",    // ㌀
+	0x3301: "アルファ",    // ㌁
+	0x3302: "アンペア",    // ㌂
+	0x3303: "アール",     // ㌃
+	0x3304: "イニング",    // ㌄
+	0x3305: "インチ",     // ㌅
+	0x3306: "ウォン",     // ㌆
+	0x3307: "エスクード",   // ㌇
+	0x3308: "エーカー",    // ㌈
+	0x3309: "オンス",     // ㌉
+	0x330A: "オーム",     // ㌊
+	0x330B: "カイリ",     // ㌋
+	0x330C: "カラット",    // ㌌
+	0x330D: "カロリー",    // ㌍
+	0x330E: "ガロン",     // ㌎
+	0x330F: "ガンマ",     // ㌏
+	0x3310: "ギガ",      // ㌐
+	0x3311: "ギニー",     // ㌑
+	0x3312: "キュリー",    // ㌒
+	0x3313: "ギルダー",    // ㌓
+	0x3314: "キロ",      // ㌔
+	0x3315: "キログラム",   // ㌕
+	0x3316: "キロメートル",  // ㌖
+	0x3317: "キロワット",   // ㌗
+	0x3318: "グラム",     // ㌘
+	0x3319: "グラムトン",   // ㌙
+	0x331A: "クルゼイロ",   // ㌚
+	0x331B: "クローネ",    // ㌛
+	0x331C: "ケース",     // ㌜
+	0x331D: "コルナ",     // ㌝
+	0x331E: "コーポ",     // ㌞
+	0x331F: "サイクル",    // ㌟
+	0x3320: "サンチーム",   // ㌠
+	0x3321: "シリング",    // ㌡
+	0x3322: "センチ",     // ㌢
+	0x3323: "セント",     // ㌣
+	0x3324: "ダース",     // ㌤
+	0x3325: "デシ",      // ㌥
+	0x3326: "ドル",      // ㌦
+	0x3327: "トン",      // ㌧
+	0x3328: "ナノ",      // ㌨
+	0x3329: "ノット",     // ㌩
+	0x332A: "ハイツ",     // ㌪
+	0x332B: "パーセント",   // ㌫
+	0x332C: "パーツ",     // ㌬
+	0x332D: "バーレル",    // ㌭
+	0x332E: "ピアストル",   // ㌮
+	0x332F: "ピクル",     // ㌯
+	0x3330: "ピコ",      // ㌰
+	0x3331: "ビル",      // ㌱
+	0x3332: "ファラッド",   // ㌲
+	0x3333: "フィート",    // ㌳
+	0x3334: "ブッシェル",   // ㌴
+	0x3335: "フラン",     // ㌵
+	0x3336: "ヘクタール",   // ㌶
+	0x3337: "ペソ",      // ㌷
+	0x3338: "ペニヒ",     // ㌸
+	0x3339: "ヘルツ",     // ㌹
+	0x333A: "ペンス",     // ㌺
+	0x333B: "ページ",     // ㌻
+	0x333C: "ベータ",     // ㌼
+	0x333D: "ポイント",    // ㌽
+	0x333E: "ボルト",     // ㌾
+	0x333F: "ホン",      // ㌿
+	0x3340: "ポンド",     // ㍀
+	0x3341: "ホール",     // ㍁
+	0x3342: "ホーン",     // ㍂
+	0x3343: "マイクロ",    // ㍃
+	0x3344: "マイル",     // ㍄
+	0x3345: "マッハ",     // ㍅
+	0x3346: "マルク",     // ㍆
+	0x3347: "マンション",   // ㍇
+	0x3348: "ミクロン",    // ㍈
+	0x3349: "ミリ",      // ㍉
+	0x334A: "ミリバール",   // ㍊
+	0x334B: "メガ",      // ㍋
+	0x334C: "メガトン",    // ㍌
+	0x334D: "メートル",    // ㍍
+	0x334E: "ヤード",     // ㍎
+	0x334F: "ヤール",     // ㍏
+	0x3350: "ユアン",     // ㍐
+	0x3351: "リットル",    // ㍑
+	0x3352: "リラ",      // ㍒
+	0x3353: "ルピー",     // ㍓
+	0x3354: "ルーブル",    // ㍔
+	0x3355: "レム",      // ㍕
+	0x3356: "レントゲン",   // ㍖
+	0x3357: "ワット",     // ㍗
+	0x3358: "0点",      // ㍘
+	0x3359: "1点",      // ㍙
+	0x335A: "2点",      // ㍚
+	0x335B: "3点",      // ㍛
+	0x335C: "4点",      // ㍜
+	0x335D: "5点",      // ㍝
+	0x335E: "6点",      // ㍞
+	0x335F: "7点",      // ㍟
+	0x3360: "8点",      // ㍠
+	0x3361: "9点",      // ㍡
+	0x3362: "10点",     // ㍢
+	0x3363: "11点",     // ㍣
+	0x3364: "12点",     // ㍤
+	0x3365: "13点",     // ㍥
+	0x3366: "14点",     // ㍦
+	0x3367: "15点",     // ㍧
+	0x3368: "16点",     // ㍨
+	0x3369: "17点",     // ㍩
+	0x336A: "18点",     // ㍪
+	0x336B: "19点",     // ㍫
+	0x336C: "20点",     // ㍬
+	0x336D: "21点",     // ㍭
+	0x336E: "22点",     // ㍮
+	0x336F: "23点",     // ㍯
+	0x3370: "24点",     // ㍰
+	0x3371: "hPa",     // ㍱
+	0x3372: "da",      // ㍲
+	0x3373: "AU",      // ㍳
+	0x3374: "bar",     // ㍴
+	0x3375: "oV",      // ㍵
+	0x3376: "pc",      // ㍶
+	0x3377: "dm",      // ㍷
+	0x3378: "dm2",     // ㍸
+	0x3379: "dm3",     // ㍹
+	0x337A: "IU",      // ㍺
+	0x337B: "平成",      // ㍻
+	0x337C: "昭和",      // ㍼
+	0x337D: "大正",      // ㍽
+	0x337E: "明治",      // ㍾
+	0x337F: "株式会社",    // ㍿
+	0x3380: "pA",      // ㎀
+	0x3381: "nA",      // ㎁
+	0x3382: "μA",      // ㎂
+	0x3383: "mA",      // ㎃
+	0x3384: "kA",      // ㎄
+	0x3385: "KB",      // ㎅
+	0x3386: "MB",      // ㎆
+	0x3387: "GB",      // ㎇
+	0x3388: "cal",     // ㎈
+	0x3389: "kcal",    // ㎉
+	0x338A: "pF",      // ㎊
+	0x338B: "nF",      // ㎋
+	0x338C: "μF",      // ㎌
+	0x338D: "μg",      // ㎍
+	0x338E: "mg",      // ㎎
+	0x338F: "kg",      // ㎏
+	0x3390: "Hz",      // ㎐
+	0x3391: "kHz",     // ㎑
+	0x3392: "MHz",     // ㎒
+	0x3393: "GHz",     // ㎓
+	0x3394: "THz",     // ㎔
+	0x3395: "μl",      // ㎕
+	0x3396: "ml",      // ㎖
+	0x3397: "dl",      // ㎗
+	0x3398: "kl",      // ㎘
+	0x3399: "fm",      // ㎙
+	0x339A: "nm",      // ㎚
+	0x339B: "μm",      // ㎛
+	0x339C: "mm",      // ㎜
+	0x339D: "cm",      // ㎝
+	0x339E: "km",      // ㎞
+	0x339F: "mm2",     // ㎟
+	0x33A0: "cm2",     // ㎠
+	0x33A1: "m2",      // ㎡
+	0x33A2: "km2",     // ㎢
+	0x33A3: "mm3",     // ㎣
+	0x33A4: "cm3",     // ㎤
+	0x33A5: "m3",      // ㎥
+	0x33A6: "km3",     // ㎦
+	0x33A7: "m∕s",     // ㎧
+	0x33A8: "m∕s2",    // ㎨
+	0x33A9: "Pa",      // ㎩
+	0x33AA: "kPa",     // ㎪
+	0x33AB: "MPa",     // ㎫
+	0x33AC: "GPa",     // ㎬
+	0x33AD: "rad",     // ㎭
+	0x33AE: "rad∕s",   // ㎮
+	0x33AF: "rad∕s2",  // ㎯
+	0x33B0: "ps",      // ㎰
+	0x33B1: "ns",      // ㎱
+	0x33B2: "μs",      // ㎲
+	0x33B3: "ms",      // ㎳
+	0x33B4: "pV",      // ㎴
+	0x33B5: "nV",      // ㎵
+	0x33B6: "μV",      // ㎶
+	0x33B7: "mV",      // ㎷
+	0x33B8: "kV",      // ㎸
+	0x33B9: "MV",      // ㎹
+	0x33BA: "pW",      // ㎺
+	0x33BB: "nW",      // ㎻
+	0x33BC: "μW",      // ㎼
+	0x33BD: "mW",      // ㎽
+	0x33BE: "kW",      // ㎾
+	0x33BF: "MW",      // ㎿
+	0x33C0: "kΩ",      // ㏀
+	0x33C1: "MΩ",      // ㏁
+	0x33C2: "a.m.",    // ㏂
+	0x33C3: "Bq",      // ㏃
+	0x33C4: "cc",      // ㏄
+	0x33C5: "cd",      // ㏅
+	0x33C6: "C∕kg",    // ㏆
+	0x33C7: "Co.",     // ㏇
+	0x33C8: "dB",      // ㏈
+	0x33C9: "Gy",      // ㏉
+	0x33CA: "ha",      // ㏊
+	0x33CB: "HP",      // ㏋
+	0x33CC: "in",      // ㏌
+	0x33CD: "KK",      // ㏍
+	0x33CE: "KM",      // ㏎
+	0x33CF: "kt",      // ㏏
+	0x33D0: "lm",      // ㏐
+	0x33D1: "ln",      // ㏑
+	0x33D2: "log",     // ㏒
+	0x33D3: "lx",      // ㏓
+	0x33D4: "mb",      // ㏔
+	0x33D5: "mil",     // ㏕
+	0x33D6: "mol",     // ㏖
+	0x33D7: "PH",      // ㏗
+	0x33D8: "p.m.",    // ㏘
+	0x33D9: "PPM",     // ㏙
+	0x33DA: "PR",      // ㏚
+	0x33DB: "sr",      // ㏛
+	0x33DC: "Sv",      // ㏜
+	0x33DD: "Wb",      // ㏝
+	0x33DE: "V∕m",     // ㏞
+	0x33DF: "A∕m",     // ㏟
+	0x33E0: "1日",      // ㏠
+	0x33E1: "2日",      // ㏡
+	0x33E2: "3日",      // ㏢
+	0x33E3: "4日",      // ㏣
+	0x33E4: "5日",      // ㏤
+	0x33E5: "6日",      // ㏥
+	0x33E6: "7日",      // ㏦
+	0x33E7: "8日",      // ㏧
+	0x33E8: "9日",      // ㏨
+	0x33E9: "10日",     // ㏩
+	0x33EA: "11日",     // ㏪
+	0x33EB: "12日",     // ㏫
+	0x33EC: "13日",     // ㏬
+	0x33ED: "14日",     // ㏭
+	0x33EE: "15日",     // ㏮
+	0x33EF: "16日",     // ㏯
+	0x33F0: "17日",     // ㏰
+	0x33F1: "18日",     // ㏱
+	0x33F2: "19日",     // ㏲
+	0x33F3: "20日",     // ㏳
+	0x33F4: "21日",     // ㏴
+	0x33F5: "22日",     // ㏵
+	0x33F6: "23日",     // ㏶
+	0x33F7: "24日",     // ㏷
+	0x33F8: "25日",     // ㏸
+	0x33F9: "26日",     // ㏹
+	0x33FA: "27日",     // ㏺
+	0x33FB: "28日",     // ㏻
+	0x33FC: "29日",     // ㏼
+	0x33FD: "30日",     // ㏽
+	0x33FE: "31日",     // ㏾
+	0x33FF: "gal",     // ㏿
 }
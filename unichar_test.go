@@ -9,9 +9,10 @@ import (
 )
 
 func (c *unichar) String() string {
-	return fmt.Sprintf("(%x,%x,%x,%x,%x,%x,%x,%x,%x)",
+	return fmt.Sprintf("(%x,%x,%x,%x,%x,%x,%x,%x,%x,%x)",
 		c.codepoint, c.category, c.charCase, c.charWidth,
-		c.voicing, c.compatCase, c.compatWidth, c.compatVoiced, c.compatSemivoiced)
+		c.voicing, c.compatCase, c.compatWidth, c.compatVoiced, c.compatSemivoiced,
+		c.compatSize)
 }
 
 type tableInfo struct {
@@ -22,10 +23,14 @@ type tableInfo struct {
 }
 
 var tables = []tableInfo{
-	{latinTable, "latinTable", 96, "d90c9a10f72b6c029cb6aba58128f534d1935aa760fd5a073c232e16fc4eca22"},
-	{kanaTable, "kanaTable", 256, "d7c8dd3e5efb23e2447498e580852728f5663b19ae146de5ad527262bcbdf138"},
-	{kanaExtTable, "kanaExtTable", 16, "4c23e39933c8312f42c602a7b602041fed92634c8936cd57786c45ebe8df52b2"},
-	{widthTable, "widthTable", 160, "79f5d0526d0696730a8e55526da769628e8280d100e954ea62151ff54699708d"},
+	{latinTable, "latinTable", 96, "65841ddbdde586e0a042b4403e5cc2dcb24496675f41be0e6598bed4c1391a95"},
+	{letterlikeTable, "letterlikeTable", 80, "9520193d03627b407725de2ac0013bab39ed5b9beec39794ed92ba071c9369ba"},
+	{enclosedTable, "enclosedTable", 160, "36af0169ecfe7b05bba00de3e4d67c7b450400e3bff0b1736c26bbd2a89fcccf"},
+	{kanaTable, "kanaTable", 256, "4e87e8657e01aebcef2e66e78106d0abc0f956823c73cfa8c7039d6a53ad0bdc"},
+	{kanaExtTable, "kanaExtTable", 16, "3b2b6cf70740577f2253a5831d8fde321fa7acc6b6f66e70ab42470ab35e68b5"},
+	{enclosedCJKTable, "enclosedCJKTable", 256, "c4a01ff116449a26222efe76511f1323486fb92e5b4e4b0593eccd562a3de90b"},
+	{cjkCompatTable, "cjkCompatTable", 256, "ceee89a3d10e9c31226eb0b6d626eac3528f40c940a32040479451443a214ea1"},
+	{widthTable, "widthTable", 160, "c8947e7ac0b635e4d3b4cdff576ec9e5ec088f1d46eb4d6e2222f4c5200398c8"},
 }
 
 func TestTableChecksums(t *testing.T) {
@@ -65,8 +70,12 @@ func testTableSequence(t *testing.T, table []unichar, name string) {
 
 func TestTableSequence(t *testing.T) {
 	testTableSequence(t, latinTable, "latinTable")
+	testTableSequence(t, letterlikeTable, "letterlikeTable")
+	testTableSequence(t, enclosedTable, "enclosedTable")
 	testTableSequence(t, kanaTable, "kanaTable")
 	testTableSequence(t, kanaExtTable, "kanaExtTable")
+	testTableSequence(t, enclosedCJKTable, "enclosedCJKTable")
+	testTableSequence(t, cjkCompatTable, "cjkCompatTable")
 	testTableSequence(t, widthTable, "widthTable")
 }
 
@@ -82,7 +91,7 @@ func testUnicharTable(t *testing.T, table []unichar, first, last rune, name stri
 		// category
 		switch c.category {
 		case ctUndefined, ctLatinLetter, ctLatinDigit, ctLatinSymbol,
-			ctKanaLetter, ctKanaSymbol, ctKanaVom:
+			ctKanaLetter, ctKanaSymbol, ctKanaVom, ctEnclosed, ctCompat:
 		default: // TEST_P8w4qtsm
 			t.Errorf("%s[%#U].category == %d, want %d <= category < %d",
 				name, c.codepoint, c.category, ctUndefined, ctMax)
@@ -129,7 +138,7 @@ func testUnicharTable(t *testing.T, table []unichar, first, last rune, name stri
 				name, c.codepoint, c.charWidth, cwUndefined, cwMax)
 		}
 		if c.charWidth == cwUndefined {
-			if c.category != ctUndefined {
+			if c.category != ctUndefined && c.category != ctEnclosed && c.category != ctCompat {
 				t.Errorf("%s[%#U].charWidth == %d, want charWidth != %d",
 					name, c.codepoint, c.charWidth, cwUndefined)
 			}
@@ -252,6 +261,30 @@ func testUnicharTable(t *testing.T, table []unichar, first, last rune, name stri
 			}
 		}
 
+		// size
+		if c.compatSize != c.codepoint {
+			if compatSize, ok := findUnichar(c.compatSize); !ok { // TEST_Wq4r7ZsL
+				t.Errorf("%s[%#U].compatSize %#U is not found by findUnichar()", name, c.codepoint, c.compatSize)
+			} else {
+				if c.category != ctKanaLetter || compatSize.category != ctKanaLetter {
+					t.Errorf("%s[%#U].category is %d and compatSize %#U.category is %d, want %d",
+						name, c.codepoint, c.category, compatSize.codepoint, compatSize.category, ctKanaLetter)
+				}
+				if c.charCase != compatSize.charCase {
+					t.Errorf("%s[%#U].charCase is %d and compatSize %#U.charCase is %d, want same value",
+						name, c.codepoint, c.charCase, compatSize.codepoint, compatSize.charCase)
+				}
+				if c.charWidth != compatSize.charWidth {
+					t.Errorf("%s[%#U].charWidth is %d and compatSize %#U.charWidth is %d, want same value",
+						name, c.codepoint, c.charWidth, compatSize.codepoint, compatSize.charWidth)
+				}
+				if compatSize.compatSize != compatSize.codepoint {
+					t.Errorf("%s[%#U].compatSize %#U.compatSize is %#U, want %#U",
+						name, c.codepoint, compatSize.codepoint, compatSize.compatSize, compatSize.codepoint)
+				}
+			}
+		}
+
 		switch c.category {
 		// Latin
 		case ctLatinLetter, ctLatinDigit, ctLatinSymbol:
@@ -298,6 +331,20 @@ func testUnicharTable(t *testing.T, table []unichar, first, last rune, name stri
 			if c.voicing != vcUndefined {
 				t.Errorf("%s[%#U].voicing is %d, want 0", name, c.codepoint, c.voicing)
 			}
+		// Enclosed, Compatibility
+		case ctEnclosed, ctCompat:
+			if c.charCase != ccUndefined {
+				t.Errorf("%s[%#U].charCase is %d, want 0", name, c.codepoint, c.charCase)
+			}
+			if c.charWidth != cwUndefined {
+				t.Errorf("%s[%#U].charWidth is %d, want 0", name, c.codepoint, c.charWidth)
+			}
+			if c.voicing != vcUndefined {
+				t.Errorf("%s[%#U].voicing is %d, want 0", name, c.codepoint, c.voicing)
+			}
+			if s, ok := decompTable[c.codepoint]; !ok || s == "" { // TEST_Hn3vQe8D
+				t.Errorf("%s[%#U] has no decomposition", name, c.codepoint)
+			}
 		// Undefined
 		case ctUndefined:
 			if c.charCase != ccUndefined {
@@ -313,9 +360,22 @@ func testUnicharTable(t *testing.T, table []unichar, first, last rune, name stri
 	}
 }
 
+func TestDecompTable(t *testing.T) {
+	for r, s := range decompTable {
+		if c, ok := findUnichar(r); !ok || (c.category != ctEnclosed && c.category != ctCompat) {
+			t.Errorf("decompTable[%#U] = %q, but %#U is neither an enclosed nor a compatibility character", r, s, r)
+		}
+	}
+}
+
 func TestUnicharTable(t *testing.T) {
 	testUnicharTable(t, latinTable, latinFirst, latinLast, "latinTable")
+	testUnicharTable(t, letterlikeTable, letterlikeFirst, letterlikeLast, "letterlikeTable")
+	testUnicharTable(t, enclosedTable, enclosedFirst, enclosedLast, "enclosedTable")
 	testUnicharTable(t, kanaTable, kanaFirst, kanaLast, "kanaTable")
+	testUnicharTable(t, kanaExtTable, kanaExtFirst, kanaExtLast, "kanaExtTable")
+	testUnicharTable(t, enclosedCJKTable, enclosedCJKFirst, enclosedCJKLast, "enclosedCJKTable")
+	testUnicharTable(t, cjkCompatTable, cjkCompatFirst, cjkCompatLast, "cjkCompatTable")
 	testUnicharTable(t, widthTable, widthFirst, widthLast, "widthTable")
 }
 